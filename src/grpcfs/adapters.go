@@ -7,7 +7,7 @@ import (
 )
 
 type FileInfoBridge struct {
-	info pb.FileInfo
+	info *pb.FileInfo
 }
 
 func (b *FileInfoBridge) Name() string {
@@ -31,17 +31,21 @@ func (b *FileInfoBridge) IsDir() bool {
 }
 
 type Sys struct {
-	Ino uint64
+	Dev   uint64
+	Ino   uint64
+	Nlink uint64
 }
 
 func (b *FileInfoBridge) Sys() any {
 	return &Sys{
-		Ino: b.info.Ino,
+		Dev:   b.info.Dev,
+		Ino:   b.info.Ino,
+		Nlink: b.info.Nlink,
 	}
 }
 
 type DirEntryBridge struct {
-	info pb.DirEntry
+	info *pb.DirEntry
 }
 
 func (b *DirEntryBridge) Name() string {
@@ -57,6 +61,6 @@ func (b *DirEntryBridge) Type() fs.FileMode {
 }
 
 func (b *DirEntryBridge) Info() (fs.FileInfo, error) {
-	info := &FileInfoBridge{info: *b.info.Info}
+	info := &FileInfoBridge{info: b.info.Info}
 	return info, nil
 }
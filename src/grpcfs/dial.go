@@ -0,0 +1,54 @@
+// place for the options to reach the server with
+
+package grpcfs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials/local"
+)
+
+// Transport credentials for an unencrypted connection to the given address.
+// Unix sockets never leave the host, the server telling who connects through
+// the peer credentials of the client process.
+func PlaintextCredentials(address string) grpc.DialOption {
+	if strings.HasPrefix(address, "unix://") {
+		return grpc.WithTransportCredentials(local.NewCredentials())
+	}
+	return grpc.WithTransportCredentials(insecure.NewCredentials())
+}
+
+// Transport credentials for a TLS connection. The server certificate is
+// verified against the CA bundle, or the system roots when none is given, and
+// the client presents its own certificate when given one, for mutual TLS.
+func TLSCredentials(caFile string, certFile string, keyFile string, serverName string) (grpc.DialOption, error) {
+	config := &tls.Config{
+		ServerName: serverName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %v", caFile)
+		}
+	}
+	if certFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(config)), nil
+}
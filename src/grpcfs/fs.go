@@ -7,6 +7,7 @@ import (
 	"log"
 	"os"
 	"sync"
+	"syscall"
 
 	pb "grpcfs/pb"
 
@@ -14,60 +15,125 @@ import (
 	"github.com/jacobsa/fuse/fuseops"
 	"github.com/jacobsa/fuse/fuseutil"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type grpcFs struct {
 	fuseutil.NotImplementedFileSystem
 	root   string
-	inodes *sync.Map
-	logger *log.Logger
-	client pb.FuseServiceClient
+	inodes *inodeTable
+	// the inodes of open file handles
+	handles *sync.Map
+	// the read-ahead windows of open file handles
+	readAheads *sync.Map
+	// the streams sequential writes to open file handles are sent over
+	writeStreams *sync.Map
+	config       Config
+	logger       *log.Logger
+	client       pb.FuseServiceClient
 }
 
+// tunables of a mount, zero values falling back to the defaults
+type Config struct {
+	// reads larger than this are streamed from the server in chunks of
+	// this size
+	ChunkSize int64
+	// how much to fetch ahead of sequential reads, negative to disable
+	ReadAhead int64
+	// fail any op that would modify the file system
+	ReadOnly bool
+}
+
+const (
+	DefaultChunkSize = 1 << 20
+	// keeps reads that are not streamed, and each chunk of those that are,
+	// well under the 4MB message limit of grpc
+	MaxChunkSize     = 2 << 20
+	DefaultReadAhead = 4 << 20
+)
+
 var _ fuseutil.FileSystem = &grpcFs{}
 
-// Create a file system that mirrors an existing physical path, in a readonly mode
+// Create a file system that mirrors an existing physical path, in a readonly mode.
+// The dial options must carry the transport credentials to reach the server with.
 func FuseServer(
 	grpcHost string,
 	root string,
-	logger *log.Logger) (server fuse.Server, err error) {
+	config Config,
+	logger *log.Logger,
+	opts ...grpc.DialOption) (server fuse.Server, err error) {
 
-	creds := insecure.NewCredentials()
-	conn, err := grpc.NewClient(grpcHost, grpc.WithTransportCredentials(creds))
+	if config.ChunkSize <= 0 {
+		config.ChunkSize = DefaultChunkSize
+	}
+	config.ChunkSize = min(config.ChunkSize, MaxChunkSize)
+	if config.ReadAhead == 0 {
+		config.ReadAhead = DefaultReadAhead
+	}
+
+	conn, err := grpc.NewClient(grpcHost, opts...)
 	if err != nil {
 		return nil, err
 	}
 	client := pb.NewFuseServiceClient(conn)
 
-	if _, err = getStat(client, context.TODO(), root); err != nil {
+	rootInfo, err := getStat(client, context.TODO(), root)
+	if err != nil {
 		logger.Print("error in getStat() for FS root", err)
 		return nil, err
 	}
 
-	inodes := &sync.Map{}
+	rootStat := rootInfo.Sys().(*Sys)
+	inodes := newInodeTable()
 	rootInode := &inodeEntry{
 		id:     fuseops.RootInodeID,
+		dev:    rootStat.Dev,
+		ino:    rootStat.Ino,
 		path:   root,
 		client: client,
 	}
-	inodes.Store(rootInode.Id(), rootInode)
+	inodes.Store(rootInode)
 	server = fuseutil.NewFileSystemServer(&grpcFs{
-		root:   root,
-		inodes: inodes,
-		logger: logger,
-		client: client,
+		root:         root,
+		inodes:       inodes,
+		handles:      &sync.Map{},
+		readAheads:   &sync.Map{},
+		writeStreams: &sync.Map{},
+		config:       config,
+		logger:       logger,
+		client:       client,
 	})
 	return
 }
 
+// Lists the exports of a server available to the gateway set in the dial
+// options
+func ListExports(grpcHost string, opts ...grpc.DialOption) ([]*pb.ExportInfo, error) {
+	conn, err := grpc.NewClient(grpcHost, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	return listExports(pb.NewFuseServiceClient(conn), context.TODO())
+}
+
+// rejects ops modifying a read-only mount, without a round trip to the server
+func (fs *grpcFs) checkWritable() error {
+	if fs.config.ReadOnly {
+		return syscall.EROFS
+	}
+	return nil
+}
+
 func (fs *grpcFs) StatFS(
 	ctx context.Context,
 	op *fuseops.StatFSOp) error {
 
 	res, err := getStatFs(fs.client, ctx, fs.root)
 	if err != nil {
-		return err
+		fs.logger.Printf("fs.StatFS - getStatFs failed: %v", err)
+		return fuseErr(err)
 	}
 	op.BlockSize = res.BlockSize
 	op.Blocks = res.Blocks
@@ -83,23 +149,36 @@ func (fs *grpcFs) LookUpInode(
 	ctx context.Context,
 	op *fuseops.LookUpInodeOp) error {
 	fs.logger.Print("fs.LookUpInode - called. ", op)
-	entry, err := getOrCreateInode(fs.inodes, fs.client, ctx, op.Parent, op.Name)
+	entry, fileInfo, err := getOrCreateInode(fs.inodes, fs.client, ctx, op.Parent, op.Name)
 	if err == nil && entry == nil {
 		fs.logger.Print("fs.LookUpInode - file does not exist. ", op.Name)
 		return fuse.ENOENT
 	}
 	if err != nil {
 		fs.logger.Printf("fs.LookUpInode - '%v' on '%v': %v", entry, op.Name, err)
-		return fuse.EIO
+		return fuseErr(err)
 	}
+	// the attributes come from the same stat as the inode, as failing past
+	// this point would leave the lookup counted without the kernel knowing
 	outputEntry := &op.Entry
 	outputEntry.Child = entry.Id()
-	attributes, err := entry.Attributes()
-	if err != nil {
-		fs.logger.Printf("fs.LookUpInode.Attributes for '%v' on '%v': %v", entry, op.Name, err)
-		return fuse.EIO
+	outputEntry.Attributes = *attributesOf(fileInfo)
+	return nil
+}
+
+func (fs *grpcFs) ForgetInode(
+	ctx context.Context,
+	op *fuseops.ForgetInodeOp) error {
+	fs.inodes.forget(op.Inode, op.N)
+	return nil
+}
+
+func (fs *grpcFs) BatchForget(
+	ctx context.Context,
+	op *fuseops.BatchForgetOp) error {
+	for _, entry := range op.Entries {
+		fs.inodes.forget(entry.Inode, entry.N)
 	}
-	outputEntry.Attributes = *attributes
 	return nil
 }
 
@@ -110,10 +189,14 @@ func (fs *grpcFs) GetInodeAttributes(
 	if !found {
 		return fuse.ENOENT
 	}
-	attributes, err := entry.(Inode).Attributes()
+	if err := fs.flushInode(op.Inode); err != nil {
+		fs.logger.Printf("fs.GetInodeAttributes - flushing writes failed for '%v': %v", entry, err)
+		return fuseErr(err)
+	}
+	attributes, err := entry.Attributes()
 	if err != nil {
 		fs.logger.Printf("fs.GetInodeAttributes for '%v': %v", entry, err)
-		return fuse.EIO
+		return fuseErr(err)
 	}
 	op.Attributes = *attributes
 	return nil
@@ -122,7 +205,18 @@ func (fs *grpcFs) GetInodeAttributes(
 func (fs *grpcFs) OpenDir(
 	ctx context.Context,
 	op *fuseops.OpenDirOp) error {
-	// Allow opening any directory.
+	var entry, found = fs.inodes.Load(op.Inode)
+	if !found {
+		return fuse.ENOENT
+	}
+	path := entry.Path()
+	fs.logger.Print("fs.OpenDir - called for ", path)
+	handle, err := openDir(fs.client, ctx, path)
+	if err != nil {
+		fs.logger.Printf("fs.OpenDir - failed for '%v': %v", entry, err)
+		return fuseErr(err)
+	}
+	op.Handle = fuseops.HandleID(handle)
 	return nil
 }
 
@@ -136,11 +230,18 @@ func (fs *grpcFs) ReadDir(
 		return fuse.ENOENT
 	}
 	log.Print("fs.ReadDir - found requested dir. ", entry)
-	children, err := entry.(Inode).ListChildren(fs.inodes)
+	// ids reserved by a previous listing of the directory are dropped when
+	// it is listed anew, so that they do not pile up
+	if op.Offset == 0 {
+		fs.inodes.unreserve(op.Inode)
+	}
+	// the server lists the entries, and their stats, as read when the handle
+	// was opened, so offsets stay stable across calls
+	children, err := entry.ListChildren(fs.inodes, op.Handle)
 	log.Print("fs.ReadDir - requested children. ", entry)
 	if err != nil {
 		fs.logger.Printf("fs.ReadDir - ListChildren of '%v' failed: %v", entry, err)
-		return fuse.EIO
+		return fuseErr(err)
 	}
 	fs.logger.Printf("fs.ReadDir - Got children of '%v': %v", entry, children)
 	if op.Offset > fuseops.DirOffset(len(children)) {
@@ -159,36 +260,56 @@ func (fs *grpcFs) ReadDir(
 	return nil
 }
 
+func (fs *grpcFs) ReleaseDirHandle(
+	ctx context.Context,
+	op *fuseops.ReleaseDirHandleOp) error {
+	fs.logger.Print("fs.ReleaseDirHandle - called for ", op.Handle)
+	if err := releaseHandle(fs.client, ctx, uint64(op.Handle)); err != nil {
+		fs.logger.Printf("fs.ReleaseDirHandle - failed for '%v': %v", op.Handle, err)
+		return fuseErr(err)
+	}
+	return nil
+}
+
 func (fs *grpcFs) OpenFile(
 	ctx context.Context,
 	op *fuseops.OpenFileOp) error {
-
-	var _, found = fs.inodes.Load(op.Inode)
+	var entry, found = fs.inodes.Load(op.Inode)
 	if !found {
 		return fuse.ENOENT
 	}
+	path := entry.Path()
+	fs.logger.Print("fs.OpenFile - called for ", path, op.OpenFlags)
+	if !op.OpenFlags.IsReadOnly() {
+		if err := fs.checkWritable(); err != nil {
+			return err
+		}
+	}
+	handle, err := openFile(fs.client, ctx, path, uint32(op.OpenFlags))
+	if err != nil {
+		fs.logger.Printf("fs.OpenFile - failed for '%v': %v", entry, err)
+		return fuseErr(err)
+	}
+	op.Handle = fuseops.HandleID(handle)
+	entry.AddHandle(op.Handle)
+	fs.handles.Store(op.Handle, entry)
 	return nil
-
 }
 
+// reads and writes go through the server-side handle rather than the inode,
+// so they keep working on files unlinked while open
 func (fs *grpcFs) ReadFile(
 	ctx context.Context,
 	op *fuseops.ReadFileOp) error {
-	var entry, found = fs.inodes.Load(op.Inode)
-	if !found {
-		return fuse.ENOENT
+	if err := fs.flushInode(op.Inode); err != nil {
+		fs.logger.Printf("fs.ReadFile - flushing writes failed for '%v': %v", op.Inode, err)
+		return fuseErr(err)
 	}
-	contents, err := entry.(Inode).Contents()
+	contents, err := fs.read(ctx, op.Inode, op.Handle, op.Offset, int64(len(op.Dst)))
 	if err != nil {
-		fs.logger.Printf("fs.ReadFile - failed for '%v': %v", entry, err)
-		return fuse.EIO
+		fs.logger.Printf("fs.ReadFile - failed for '%v': %v", op.Inode, err)
+		return fuseErr(err)
 	}
-
-	if op.Offset > int64(len(contents)) {
-		return fuse.EIO
-	}
-
-	contents = contents[op.Offset:]
 	op.BytesRead = copy(op.Dst, contents)
 	return nil
 }
@@ -196,16 +317,55 @@ func (fs *grpcFs) ReadFile(
 func (fs *grpcFs) WriteFile(
 	ctx context.Context,
 	op *fuseops.WriteFileOp) error {
-	var entry, found = fs.inodes.Load(op.Inode)
-	if !found {
-		return fuse.ENOENT
+	if err := fs.checkWritable(); err != nil {
+		return err
 	}
-	path := entry.(Inode).Path()
-	fs.logger.Print("fs.WriteFile - called for", path)
-	res, err := writeFile(fs.client, ctx, path, op.Data, op.Offset)
-	if !res || (err != nil) {
-		fs.logger.Printf("fs.WriteFile - failed for '%v': %v", entry, err)
-		return fuse.EIO
+	fs.logger.Print("fs.WriteFile - called for ", op.Inode, op.Handle)
+	fs.dropReadAhead(op.Inode)
+	if err := fs.write(ctx, op.Inode, op.Handle, op.Data, op.Offset); err != nil {
+		fs.logger.Printf("fs.WriteFile - failed for '%v': %v", op.Inode, err)
+		return fuseErr(err)
+	}
+	return nil
+}
+
+func (fs *grpcFs) FlushFile(
+	ctx context.Context,
+	op *fuseops.FlushFileOp) error {
+	fs.logger.Print("fs.FlushFile - called for ", op.Inode, op.Handle)
+	if err := fs.flushHandle(op.Handle); err != nil {
+		fs.logger.Printf("fs.FlushFile - failed for '%v': %v", op.Inode, err)
+		return fuseErr(err)
+	}
+	return nil
+}
+
+func (fs *grpcFs) SyncFile(
+	ctx context.Context,
+	op *fuseops.SyncFileOp) error {
+	fs.logger.Print("fs.SyncFile - called for ", op.Inode, op.Handle)
+	if err := fs.flushHandle(op.Handle); err != nil {
+		fs.logger.Printf("fs.SyncFile - failed for '%v': %v", op.Inode, err)
+		return fuseErr(err)
+	}
+	return nil
+}
+
+func (fs *grpcFs) ReleaseFileHandle(
+	ctx context.Context,
+	op *fuseops.ReleaseFileHandleOp) error {
+	fs.logger.Print("fs.ReleaseFileHandle - called for ", op.Handle)
+	if err := fs.flushHandle(op.Handle); err != nil {
+		fs.logger.Printf("fs.ReleaseFileHandle - flushing writes failed for '%v': %v", op.Handle, err)
+	}
+	fs.writeStreams.Delete(op.Handle)
+	fs.readAheads.Delete(op.Handle)
+	if entry, found := fs.handles.LoadAndDelete(op.Handle); found {
+		entry.(Inode).RemoveHandle(op.Handle)
+	}
+	if err := releaseHandle(fs.client, ctx, uint64(op.Handle)); err != nil {
+		fs.logger.Printf("fs.ReleaseFileHandle - failed for '%v': %v", op.Handle, err)
+		return fuseErr(err)
 	}
 	return nil
 }
@@ -213,16 +373,28 @@ func (fs *grpcFs) WriteFile(
 func (fs *grpcFs) SetInodeAttributes(
 	ctx context.Context,
 	op *fuseops.SetInodeAttributesOp) error {
+	if err := fs.checkWritable(); err != nil {
+		return err
+	}
 	var entry, found = fs.inodes.Load(op.Inode)
 	if !found {
 		return fuse.ENOENT
 	}
-	path := entry.(Inode).Path()
+	path := entry.Path()
 	fs.logger.Print("fs.SetInodeAttributes - called for ", path)
-	res, err := setInodeAttributes(fs.client, ctx, path, op.Size, (*uint32)(op.Mode), op.Atime, op.Mtime)
+	if err := fs.flushInode(op.Inode); err != nil {
+		fs.logger.Printf("fs.SetInodeAttributes - flushing writes failed for '%v': %v", entry, err)
+		return fuseErr(err)
+	}
+	fs.dropReadAhead(op.Inode)
+	var handle uint64
+	if op.Handle != nil {
+		handle = uint64(*op.Handle)
+	}
+	res, err := setInodeAttributes(fs.client, ctx, path, handle, op.Size, (*uint32)(op.Mode), op.Atime, op.Mtime)
 	if (res == nil) || (err != nil) {
 		fs.logger.Printf("fs.SetInodeAttributes - failed for '%v': %v", entry, err)
-		return fuse.EIO
+		return fuseErr(err)
 	}
 	op.Attributes.Size = res.Size
 	op.Attributes.Mode = os.FileMode(res.FileMode)
@@ -230,3 +402,316 @@ func (fs *grpcFs) SetInodeAttributes(
 	op.Attributes.Mtime = res.Mtime.AsTime()
 	return nil
 }
+
+func (fs *grpcFs) CreateFile(
+	ctx context.Context,
+	op *fuseops.CreateFileOp) error {
+	if err := fs.checkWritable(); err != nil {
+		return err
+	}
+	path, found := childPath(fs.inodes, op.Parent, op.Name)
+	if !found {
+		return fuse.ENOENT
+	}
+	fs.logger.Print("fs.CreateFile - called for ", path, op.Mode)
+	fileInfo, handle, err := createFile(fs.client, ctx, path, uint32(op.Mode))
+	if err != nil {
+		fs.logger.Printf("fs.CreateFile - failed for '%v': %v", path, err)
+		return fuseErr(err)
+	}
+	entry := storeInode(fs.inodes, fs.client, path, fileInfo)
+	op.Entry.Child = entry.Id()
+	op.Entry.Attributes = *attributesOf(fileInfo)
+	op.Handle = fuseops.HandleID(handle)
+	entry.AddHandle(op.Handle)
+	fs.handles.Store(op.Handle, entry)
+	return nil
+}
+
+func (fs *grpcFs) MkNode(
+	ctx context.Context,
+	op *fuseops.MkNodeOp) error {
+	if err := fs.checkWritable(); err != nil {
+		return err
+	}
+	// only regular files can be created on the server
+	if op.Mode&os.ModeType != 0 {
+		return fuse.ENOSYS
+	}
+	path, found := childPath(fs.inodes, op.Parent, op.Name)
+	if !found {
+		return fuse.ENOENT
+	}
+	fs.logger.Print("fs.MkNode - called for ", path, op.Mode)
+	fileInfo, handle, err := createFile(fs.client, ctx, path, uint32(op.Mode))
+	if err != nil {
+		fs.logger.Printf("fs.MkNode - failed for '%v': %v", path, err)
+		return fuseErr(err)
+	}
+	// the node is only created, not opened
+	releaseHandle(fs.client, ctx, handle)
+	entry := storeInode(fs.inodes, fs.client, path, fileInfo)
+	op.Entry.Child = entry.Id()
+	op.Entry.Attributes = *attributesOf(fileInfo)
+	return nil
+}
+
+func (fs *grpcFs) MkDir(
+	ctx context.Context,
+	op *fuseops.MkDirOp) error {
+	if err := fs.checkWritable(); err != nil {
+		return err
+	}
+	path, found := childPath(fs.inodes, op.Parent, op.Name)
+	if !found {
+		return fuse.ENOENT
+	}
+	fs.logger.Print("fs.MkDir - called for ", path, op.Mode)
+	fileInfo, err := mkDir(fs.client, ctx, path, uint32(op.Mode))
+	if err != nil {
+		fs.logger.Printf("fs.MkDir - failed for '%v': %v", path, err)
+		return fuseErr(err)
+	}
+	entry := storeInode(fs.inodes, fs.client, path, fileInfo)
+	op.Entry.Child = entry.Id()
+	op.Entry.Attributes = *attributesOf(fileInfo)
+	return nil
+}
+
+func (fs *grpcFs) RmDir(
+	ctx context.Context,
+	op *fuseops.RmDirOp) error {
+	if err := fs.checkWritable(); err != nil {
+		return err
+	}
+	path, found := childPath(fs.inodes, op.Parent, op.Name)
+	if !found {
+		return fuse.ENOENT
+	}
+	fs.logger.Print("fs.RmDir - called for ", path)
+	if err := rmDir(fs.client, ctx, path); err != nil {
+		fs.logger.Printf("fs.RmDir - failed for '%v': %v", path, err)
+		return fuseErr(err)
+	}
+	unlinkPath(fs.inodes, path, 0)
+	return nil
+}
+
+func (fs *grpcFs) Unlink(
+	ctx context.Context,
+	op *fuseops.UnlinkOp) error {
+	if err := fs.checkWritable(); err != nil {
+		return err
+	}
+	path, found := childPath(fs.inodes, op.Parent, op.Name)
+	if !found {
+		return fuse.ENOENT
+	}
+	fs.logger.Print("fs.Unlink - called for ", path)
+	nlink, err := unlink(fs.client, ctx, path)
+	if err != nil {
+		fs.logger.Printf("fs.Unlink - failed for '%v': %v", path, err)
+		return fuseErr(err)
+	}
+	// stale ids now fail with ENOENT instead of stat'ing a missing path,
+	// unless the file is still open or reachable through another link
+	unlinkPath(fs.inodes, path, nlink)
+	return nil
+}
+
+func (fs *grpcFs) Rename(
+	ctx context.Context,
+	op *fuseops.RenameOp) error {
+	if err := fs.checkWritable(); err != nil {
+		return err
+	}
+	oldPath, found := childPath(fs.inodes, op.OldParent, op.OldName)
+	if !found {
+		return fuse.ENOENT
+	}
+	newPath, found := childPath(fs.inodes, op.NewParent, op.NewName)
+	if !found {
+		return fuse.ENOENT
+	}
+	fs.logger.Print("fs.Rename - called for ", oldPath, newPath)
+	nlink, err := rename(fs.client, ctx, oldPath, newPath)
+	if err != nil {
+		fs.logger.Printf("fs.Rename - failed for '%v': %v", oldPath, err)
+		return fuseErr(err)
+	}
+	// anything previously at the destination has been replaced
+	unlinkPath(fs.inodes, newPath, nlink)
+	renamePath(fs.inodes, oldPath, newPath)
+	return nil
+}
+
+func (fs *grpcFs) ReadSymlink(
+	ctx context.Context,
+	op *fuseops.ReadSymlinkOp) error {
+	var entry, found = fs.inodes.Load(op.Inode)
+	if !found {
+		return fuse.ENOENT
+	}
+	path := entry.Path()
+	fs.logger.Print("fs.ReadSymlink - called for ", path)
+	target, err := readLink(fs.client, ctx, path)
+	if err != nil {
+		fs.logger.Printf("fs.ReadSymlink - failed for '%v': %v", entry, err)
+		return fuseErr(err)
+	}
+	op.Target = target
+	return nil
+}
+
+func (fs *grpcFs) CreateSymlink(
+	ctx context.Context,
+	op *fuseops.CreateSymlinkOp) error {
+	if err := fs.checkWritable(); err != nil {
+		return err
+	}
+	path, found := childPath(fs.inodes, op.Parent, op.Name)
+	if !found {
+		return fuse.ENOENT
+	}
+	fs.logger.Print("fs.CreateSymlink - called for ", path, op.Target)
+	fileInfo, err := symlink(fs.client, ctx, path, op.Target)
+	if err != nil {
+		fs.logger.Printf("fs.CreateSymlink - failed for '%v': %v", path, err)
+		return fuseErr(err)
+	}
+	entry := storeInode(fs.inodes, fs.client, path, fileInfo)
+	op.Entry.Child = entry.Id()
+	op.Entry.Attributes = *attributesOf(fileInfo)
+	return nil
+}
+
+func (fs *grpcFs) CreateLink(
+	ctx context.Context,
+	op *fuseops.CreateLinkOp) error {
+	if err := fs.checkWritable(); err != nil {
+		return err
+	}
+	var target, found = fs.inodes.Load(op.Target)
+	if !found {
+		return fuse.ENOENT
+	}
+	path, found := childPath(fs.inodes, op.Parent, op.Name)
+	if !found {
+		return fuse.ENOENT
+	}
+	targetPath := target.Path()
+	fs.logger.Print("fs.CreateLink - called for ", path, targetPath)
+	fileInfo, err := link(fs.client, ctx, path, targetPath)
+	if err != nil {
+		fs.logger.Printf("fs.CreateLink - failed for '%v': %v", path, err)
+		return fuseErr(err)
+	}
+	entry := storeInode(fs.inodes, fs.client, path, fileInfo)
+	op.Entry.Child = entry.Id()
+	op.Entry.Attributes = *attributesOf(fileInfo)
+	return nil
+}
+
+// translates an error from an xattr rpc, where a missing entry means a
+// missing attribute rather than a missing file
+func xattrErr(err error) error {
+	if _, found := errnoOf(err); !found && status.Code(err) == codes.NotFound {
+		return fuse.ENOATTR
+	}
+	return fuseErr(err)
+}
+
+func (fs *grpcFs) GetXattr(
+	ctx context.Context,
+	op *fuseops.GetXattrOp) error {
+	var entry, found = fs.inodes.Load(op.Inode)
+	if !found {
+		return fuse.ENOENT
+	}
+	path := entry.Path()
+	fs.logger.Print("fs.GetXattr - called for ", path, op.Name)
+	value, err := getXattr(fs.client, ctx, path, op.Name)
+	if err != nil {
+		fs.logger.Printf("fs.GetXattr - failed for '%v': %v", entry, err)
+		return xattrErr(err)
+	}
+	// an empty buffer asks for the size of the value only
+	op.BytesRead = len(value)
+	if len(op.Dst) == 0 {
+		return nil
+	}
+	if len(value) > len(op.Dst) {
+		return syscall.ERANGE
+	}
+	copy(op.Dst, value)
+	return nil
+}
+
+func (fs *grpcFs) ListXattr(
+	ctx context.Context,
+	op *fuseops.ListXattrOp) error {
+	var entry, found = fs.inodes.Load(op.Inode)
+	if !found {
+		return fuse.ENOENT
+	}
+	path := entry.Path()
+	fs.logger.Print("fs.ListXattr - called for ", path)
+	attrs, err := listXattr(fs.client, ctx, path)
+	if err != nil {
+		fs.logger.Printf("fs.ListXattr - failed for '%v': %v", entry, err)
+		return xattrErr(err)
+	}
+	names := []byte{}
+	for _, attr := range attrs {
+		names = append(names, attr...)
+		names = append(names, 0)
+	}
+	// an empty buffer asks for the size of the list only
+	op.BytesRead = len(names)
+	if len(op.Dst) == 0 {
+		return nil
+	}
+	if len(names) > len(op.Dst) {
+		return syscall.ERANGE
+	}
+	copy(op.Dst, names)
+	return nil
+}
+
+func (fs *grpcFs) SetXattr(
+	ctx context.Context,
+	op *fuseops.SetXattrOp) error {
+	if err := fs.checkWritable(); err != nil {
+		return err
+	}
+	var entry, found = fs.inodes.Load(op.Inode)
+	if !found {
+		return fuse.ENOENT
+	}
+	path := entry.Path()
+	fs.logger.Print("fs.SetXattr - called for ", path, op.Name, op.Flags)
+	if err := setXattr(fs.client, ctx, path, op.Name, op.Value, op.Flags); err != nil {
+		fs.logger.Printf("fs.SetXattr - failed for '%v': %v", entry, err)
+		return xattrErr(err)
+	}
+	return nil
+}
+
+func (fs *grpcFs) RemoveXattr(
+	ctx context.Context,
+	op *fuseops.RemoveXattrOp) error {
+	if err := fs.checkWritable(); err != nil {
+		return err
+	}
+	var entry, found = fs.inodes.Load(op.Inode)
+	if !found {
+		return fuse.ENOENT
+	}
+	path := entry.Path()
+	fs.logger.Print("fs.RemoveXattr - called for ", path, op.Name)
+	if err := removeXattr(fs.client, ctx, path, op.Name); err != nil {
+		fs.logger.Printf("fs.RemoveXattr - failed for '%v': %v", entry, err)
+		return xattrErr(err)
+	}
+	return nil
+}
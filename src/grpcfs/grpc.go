@@ -5,19 +5,85 @@ package grpcfs
 import (
 	"context"
 	pb "grpcfs/pb"
+	"io"
 	"io/fs"
 	"log"
+	"syscall"
 	"time"
 
+	"github.com/jacobsa/fuse"
+	"golang.org/x/sys/unix"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-var ctxt = &pb.RPCContext{}
+// the domain of the error details the server passes errnos in
+const errorDomain = "grpcfs"
+
+// translates an rpc error into the errno reported to the kernel
+func fuseErr(err error) error {
+	if errno, found := errnoOf(err); found {
+		return errno
+	}
+	switch status.Code(err) {
+	case codes.NotFound:
+		return fuse.ENOENT
+	case codes.PermissionDenied, codes.Unauthenticated:
+		return syscall.EACCES
+	case codes.AlreadyExists:
+		return fuse.EEXIST
+	case codes.FailedPrecondition:
+		return fuse.ENOTEMPTY
+	case codes.ResourceExhausted:
+		return syscall.ENOSPC
+	case codes.InvalidArgument:
+		return fuse.EINVAL
+	case codes.Unimplemented:
+		return syscall.ENOTSUP
+	}
+	return fuse.EIO
+}
+
+// extracts the errno behind an error, be it raised locally or passed along
+// in the details of an rpc status
+func errnoOf(err error) (syscall.Errno, bool) {
+	if errno, ok := err.(syscall.Errno); ok {
+		return errno, true
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return 0, false
+	}
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if !ok || info.Domain != errorDomain {
+			continue
+		}
+		// the errno is passed by name, as numbers differ between platforms
+		for errno := syscall.Errno(1); errno < 256; errno++ {
+			if unix.ErrnoName(errno) == info.Reason {
+				return errno, true
+			}
+		}
+	}
+	return 0, false
+}
+
+func listExports(fsClient pb.FuseServiceClient, ctx context.Context) ([]*pb.ExportInfo, error) {
+	req := &pb.ListExportsReq{}
+	res, err := fsClient.ListExports(ctx, req)
+	if err != nil {
+		log.Print("grpc.listExports - fsClient.ListExports raised error. ", err)
+		return nil, err
+	}
+	return res.Result, nil
+}
 
 func getStatFs(fsClient pb.FuseServiceClient, ctx context.Context, root string) (*pb.StatFs, error) {
 	req := &pb.StatFsReq{
-		Name:    root,
-		Context: ctxt,
+		Name: root,
 	}
 	res, err := fsClient.StatFs(ctx, req)
 	if err != nil {
@@ -33,8 +99,7 @@ func getStatFs(fsClient pb.FuseServiceClient, ctx context.Context, root string)
 func getStat(fsClient pb.FuseServiceClient, ctx context.Context, path string) (fs.FileInfo, error) {
 	log.Print("grpc.getStat - path=", path)
 	req := &pb.FileInfoReq{
-		Name:    path,
-		Context: ctxt,
+		Name: path,
 	}
 	log.Print("grpc.getStat - calling fsClient.FileInfo for ", path)
 	res, err := fsClient.FileInfo(ctx, req)
@@ -46,14 +111,38 @@ func getStat(fsClient pb.FuseServiceClient, ctx context.Context, path string) (f
 	if raw == nil {
 		return nil, ctx.Err()
 	}
-	result := &FileInfoBridge{info: *raw}
+	result := &FileInfoBridge{info: raw}
 	return result, err
 }
 
-func readDir(fsClient pb.FuseServiceClient, ctx context.Context, path string) ([]fs.DirEntry, error) {
+func openDir(fsClient pb.FuseServiceClient, ctx context.Context, path string) (uint64, error) {
+	req := &pb.OpenDirReq{
+		Name: path,
+	}
+	res, err := fsClient.OpenDir(ctx, req)
+	if err != nil {
+		log.Print("grpc.openDir - fsClient.OpenDir raised error. ", err)
+		return 0, err
+	}
+	return res.Result.Handle, err
+}
+
+func statHandle(fsClient pb.FuseServiceClient, ctx context.Context, handle uint64) (fs.FileInfo, error) {
+	req := &pb.FileInfoReq{
+		Handle: handle,
+	}
+	res, err := fsClient.FileInfo(ctx, req)
+	if err != nil {
+		log.Print("grpc.statHandle - fsClient.FileInfo raised error. ", err)
+		return nil, err
+	}
+	return &FileInfoBridge{info: res.Result}, err
+}
+
+func readDir(fsClient pb.FuseServiceClient, ctx context.Context, path string, handle uint64) ([]fs.DirEntry, error) {
 	req := &pb.ReadDirReq{
-		Name:    path,
-		Context: ctxt,
+		Name:   path,
+		Handle: handle,
 	}
 	res, err := fsClient.ReadDir(ctx, req)
 	if err != nil {
@@ -63,30 +152,244 @@ func readDir(fsClient pb.FuseServiceClient, ctx context.Context, path string) ([
 	raw := res.Result
 	var entries []fs.DirEntry
 	for _, entry := range raw {
-		entries = append(entries, &DirEntryBridge{info: *entry})
+		entries = append(entries, &DirEntryBridge{info: entry})
 	}
 	return entries, err
 }
 
-func readFile(fsClient pb.FuseServiceClient, ctx context.Context, path string) ([]byte, error) {
+func openFile(fsClient pb.FuseServiceClient, ctx context.Context, path string, flags uint32) (uint64, error) {
+	req := &pb.OpenFileReq{
+		Name:  path,
+		Flags: flags,
+	}
+	res, err := fsClient.OpenFile(ctx, req)
+	if err != nil {
+		log.Print("grpc.openFile - fsClient.OpenFile raised error. ", err)
+		return 0, err
+	}
+	return res.Result.Handle, err
+}
+
+func releaseHandle(fsClient pb.FuseServiceClient, ctx context.Context, handle uint64) error {
+	req := &pb.ReleaseHandleReq{
+		Handle: handle,
+	}
+	_, err := fsClient.ReleaseHandle(ctx, req)
+	if err != nil {
+		log.Print("grpc.releaseHandle - fsClient.ReleaseHandle raised error. ", err)
+	}
+	return err
+}
+
+func readFile(fsClient pb.FuseServiceClient, ctx context.Context, handle uint64, offset int64, size int64) ([]byte, error) {
 	req := &pb.ReadFileReq{
-		Name:    path,
-		Context: ctxt,
+		Offset: offset,
+		Size:   size,
+		Handle: handle,
 	}
 	res, err := fsClient.ReadFile(ctx, req)
+	if err != nil {
+		log.Print("grpc.readFile - fsClient.ReadFile raised error. ", err)
+		return nil, err
+	}
 	return res.Result.Dst, err
 }
 
-func writeFile(fsClient pb.FuseServiceClient, ctx context.Context, path string, data []byte, offset int64) (bool, error) {
+// reads a range too large for a single message as a stream of chunks
+func readFileStream(fsClient pb.FuseServiceClient, ctx context.Context, handle uint64, offset int64, size int64, chunkSize int64) ([]byte, error) {
+	req := &pb.ReadFileReq{
+		Offset:    offset,
+		Size:      size,
+		Handle:    handle,
+		ChunkSize: chunkSize,
+	}
+	stream, err := fsClient.ReadFileStream(ctx, req)
+	if err != nil {
+		log.Print("grpc.readFileStream - fsClient.ReadFileStream raised error. ", err)
+		return nil, err
+	}
+	dst := make([]byte, 0, size)
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return dst, nil
+		}
+		if err != nil {
+			log.Print("grpc.readFileStream - stream.Recv raised error. ", err)
+			return nil, err
+		}
+		dst = append(dst, res.Result.Dst...)
+	}
+}
+
+func writeFile(fsClient pb.FuseServiceClient, ctx context.Context, handle uint64, data []byte, offset int64) (int64, error) {
 	req := &pb.WriteFileReq{
-		Name:    path,
-		Context: ctxt,
+		Data:   data,
+		Offset: offset,
+		Handle: handle,
 	}
 	res, err := fsClient.WriteFile(ctx, req)
+	if err != nil {
+		log.Print("grpc.writeFile - fsClient.WriteFile raised error. ", err)
+		return 0, err
+	}
+	return res.BytesWritten, err
+}
+
+func createFile(fsClient pb.FuseServiceClient, ctx context.Context, path string, mode uint32) (fs.FileInfo, uint64, error) {
+	req := &pb.CreateFileReq{
+		Name: path,
+		Mode: mode,
+	}
+	res, err := fsClient.CreateFile(ctx, req)
+	if err != nil {
+		log.Print("grpc.createFile - fsClient.CreateFile raised error. ", err)
+		return nil, 0, err
+	}
+	return &FileInfoBridge{info: res.Result}, res.Handle, err
+}
+
+func mkDir(fsClient pb.FuseServiceClient, ctx context.Context, path string, mode uint32) (fs.FileInfo, error) {
+	req := &pb.MkDirReq{
+		Name: path,
+		Mode: mode,
+	}
+	res, err := fsClient.MkDir(ctx, req)
+	if err != nil {
+		log.Print("grpc.mkDir - fsClient.MkDir raised error. ", err)
+		return nil, err
+	}
+	return &FileInfoBridge{info: res.Result}, err
+}
+
+func rmDir(fsClient pb.FuseServiceClient, ctx context.Context, path string) error {
+	req := &pb.RmDirReq{
+		Name: path,
+	}
+	_, err := fsClient.RmDir(ctx, req)
+	if err != nil {
+		log.Print("grpc.rmDir - fsClient.RmDir raised error. ", err)
+	}
+	return err
+}
+
+// unlinks a path, returning the links the file has left
+func unlink(fsClient pb.FuseServiceClient, ctx context.Context, path string) (uint64, error) {
+	req := &pb.UnlinkReq{
+		Name: path,
+	}
+	res, err := fsClient.Unlink(ctx, req)
+	if err != nil {
+		log.Print("grpc.unlink - fsClient.Unlink raised error. ", err)
+		return 0, err
+	}
+	return res.Nlink, nil
+}
+
+// renames a path, returning the links left to the file it replaced, if any
+func rename(fsClient pb.FuseServiceClient, ctx context.Context, path string, newPath string) (uint64, error) {
+	req := &pb.RenameReq{
+		Name:    path,
+		NewName: newPath,
+	}
+	res, err := fsClient.Rename(ctx, req)
+	if err != nil {
+		log.Print("grpc.rename - fsClient.Rename raised error. ", err)
+		return 0, err
+	}
+	return res.Nlink, nil
+}
+
+func readLink(fsClient pb.FuseServiceClient, ctx context.Context, path string) (string, error) {
+	req := &pb.ReadLinkReq{
+		Name: path,
+	}
+	res, err := fsClient.ReadLink(ctx, req)
+	if err != nil {
+		log.Print("grpc.readLink - fsClient.ReadLink raised error. ", err)
+		return "", err
+	}
 	return res.Result, err
 }
 
-func setInodeAttributes(fsClient pb.FuseServiceClient, ctx context.Context, path string, size *uint64, mode *uint32, atime *time.Time, mtime *time.Time) (*pb.InodeAtt, error) {
+func symlink(fsClient pb.FuseServiceClient, ctx context.Context, path string, target string) (fs.FileInfo, error) {
+	req := &pb.SymlinkReq{
+		Name:   path,
+		Target: target,
+	}
+	res, err := fsClient.Symlink(ctx, req)
+	if err != nil {
+		log.Print("grpc.symlink - fsClient.Symlink raised error. ", err)
+		return nil, err
+	}
+	return &FileInfoBridge{info: res.Result}, err
+}
+
+func link(fsClient pb.FuseServiceClient, ctx context.Context, path string, target string) (fs.FileInfo, error) {
+	req := &pb.LinkReq{
+		Name:   path,
+		Target: target,
+	}
+	res, err := fsClient.Link(ctx, req)
+	if err != nil {
+		log.Print("grpc.link - fsClient.Link raised error. ", err)
+		return nil, err
+	}
+	return &FileInfoBridge{info: res.Result}, err
+}
+
+func getXattr(fsClient pb.FuseServiceClient, ctx context.Context, path string, attr string) ([]byte, error) {
+	req := &pb.GetXattrReq{
+		Name: path,
+		Attr: attr,
+	}
+	res, err := fsClient.GetXattr(ctx, req)
+	if err != nil {
+		log.Print("grpc.getXattr - fsClient.GetXattr raised error. ", err)
+		return nil, err
+	}
+	return res.Result, err
+}
+
+func listXattr(fsClient pb.FuseServiceClient, ctx context.Context, path string) ([]string, error) {
+	req := &pb.ListXattrReq{
+		Name: path,
+	}
+	res, err := fsClient.ListXattr(ctx, req)
+	if err != nil {
+		log.Print("grpc.listXattr - fsClient.ListXattr raised error. ", err)
+		return nil, err
+	}
+	return res.Result, err
+}
+
+func setXattr(fsClient pb.FuseServiceClient, ctx context.Context, path string, attr string, value []byte, flags uint32) error {
+	req := &pb.SetXattrReq{
+		Name:  path,
+		Attr:  attr,
+		Value: value,
+		Flags: flags,
+	}
+	_, err := fsClient.SetXattr(ctx, req)
+	if err != nil {
+		log.Print("grpc.setXattr - fsClient.SetXattr raised error. ", err)
+	}
+	return err
+}
+
+func removeXattr(fsClient pb.FuseServiceClient, ctx context.Context, path string, attr string) error {
+	req := &pb.RemoveXattrReq{
+		Name: path,
+		Attr: attr,
+	}
+	_, err := fsClient.RemoveXattr(ctx, req)
+	if err != nil {
+		log.Print("grpc.removeXattr - fsClient.RemoveXattr raised error. ", err)
+	}
+	return err
+}
+
+func setInodeAttributes(fsClient pb.FuseServiceClient, ctx context.Context, path string, handle uint64, size *uint64, mode *uint32, atime *time.Time, mtime *time.Time) (*pb.InodeAtt, error) {
 	var at *timestamppb.Timestamp
 	var mt *timestamppb.Timestamp
 	if atime != nil {
@@ -97,11 +400,11 @@ func setInodeAttributes(fsClient pb.FuseServiceClient, ctx context.Context, path
 	}
 	req := &pb.SetInodeAttReq{
 		Name:     path,
-		Context:  ctxt,
 		Size:     size,
 		FileMode: mode,
 		ATime:    at,
 		MTime:    mt,
+		Handle:   handle,
 	}
 	res, err := fsClient.SetInodeAtt(ctx, req)
 	if err != nil {
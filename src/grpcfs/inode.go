@@ -6,14 +6,19 @@ import (
 	"context"
 	"fmt"
 	"grpcfs/pb"
+	"io/fs"
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"sync/atomic"
 
+	"github.com/jacobsa/fuse"
 	"github.com/jacobsa/fuse/fuseops"
 	"github.com/jacobsa/fuse/fuseutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var (
@@ -24,40 +29,238 @@ var (
 
 type Inode interface {
 	Id() fuseops.InodeID
+	Dev() uint64
+	Ino() uint64
 	Path() string
+	AddLink(path string)
+	DropLinks(path string) (dropped bool, left bool)
+	MoveLinks(oldPath string, newPath string)
+	Unlink()
+	AddHandle(handle fuseops.HandleID)
+	RemoveHandle(handle fuseops.HandleID)
+	IncrementLookupCount()
+	DecrementLookupCount(n uint64) uint64
 	String() string
 	Attributes() (*fuseops.InodeAttributes, error)
-	ListChildren(inodes *sync.Map) ([]*fuseutil.Dirent, error)
-	Contents() ([]byte, error)
+	ListChildren(inodes *inodeTable, handle fuseops.HandleID) ([]*fuseutil.Dirent, error)
 }
 
-func getOrCreateInode(inodes *sync.Map, fsClient pb.FuseServiceClient, ctx context.Context, parentId fuseops.InodeID, name string) (Inode, error) {
+// identifies a file on the server, regardless of the path used to reach it
+type serverFile struct {
+	dev uint64
+	ino uint64
+}
+
+// the inodes known to the mount, indexed by their fuse id as well as by the
+// server file they stand for, so that a server file maps to a single inode
+type inodeTable struct {
+	mu     sync.RWMutex
+	byId   map[fuseops.InodeID]Inode
+	byFile map[serverFile]Inode
+	// ids handed out in directory listings for server files without an
+	// inode yet, kept so that the files are later looked up under them. They
+	// are only held for the latest listing of a directory still known to
+	// the kernel.
+	reserved map[serverFile]reservation
+	// the files reserved by the listing of each directory
+	listed map[fuseops.InodeID]map[serverFile]struct{}
+}
+
+// an id reserved for a server file, and the directory listing it
+type reservation struct {
+	id  fuseops.InodeID
+	dir fuseops.InodeID
+}
+
+func newInodeTable() *inodeTable {
+	return &inodeTable{
+		byId:     map[fuseops.InodeID]Inode{},
+		byFile:   map[serverFile]Inode{},
+		reserved: map[serverFile]reservation{},
+		listed:   map[fuseops.InodeID]map[serverFile]struct{}{},
+	}
+}
+
+func (t *inodeTable) Load(id fuseops.InodeID) (Inode, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	entry, found := t.byId[id]
+	return entry, found
+}
+
+func (t *inodeTable) Store(entry Inode) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.byId[entry.Id()] = entry
+	t.byFile[serverFile{entry.Dev(), entry.Ino()}] = entry
+}
+
+// the id the mount uses for a server file listed in a directory, reserving
+// one if the file has no inode yet
+func (t *inodeTable) idFor(dir fuseops.InodeID, file serverFile) fuseops.InodeID {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if entry, found := t.byFile[file]; found {
+		return entry.Id()
+	}
+	res, found := t.reserved[file]
+	if !found {
+		res.id = nextInodeID()
+	}
+	// a file listed in several directories is held by the latest of them
+	res.dir = dir
+	t.reserved[file] = res
+	if t.listed[dir] == nil {
+		t.listed[dir] = map[serverFile]struct{}{}
+	}
+	t.listed[dir][file] = struct{}{}
+	return res.id
+}
+
+// drops the ids reserved by the listing of a directory, once it is listed
+// anew or forgotten
+func (t *inodeTable) unreserve(dir fuseops.InodeID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.unreserveLocked(dir)
+}
+
+func (t *inodeTable) unreserveLocked(dir fuseops.InodeID) {
+	for file := range t.listed[dir] {
+		if t.reserved[file].dir == dir {
+			delete(t.reserved, file)
+		}
+	}
+	delete(t.listed, dir)
+}
+
+// drops n kernel references to an inode, evicting it once none remain
+func (t *inodeTable) forget(id fuseops.InodeID, n uint64) {
+	// the root is never looked up, so it is pinned for the mount's lifetime
+	if id == fuseops.RootInodeID {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	entry, found := t.byId[id]
+	if !found {
+		return
+	}
+	if entry.DecrementLookupCount(n) == 0 {
+		log.Print("inodeTable.forget - evicting. ", entry)
+		t.delete(entry)
+	}
+}
+
+func (t *inodeTable) delete(entry Inode) {
+	delete(t.byId, entry.Id())
+	t.unreserveLocked(entry.Id())
+	file := serverFile{entry.Dev(), entry.Ino()}
+	if t.byFile[file] == entry {
+		delete(t.byFile, file)
+	}
+}
+
+// resolves the path of a named entry under the given parent inode
+func childPath(inodes *inodeTable, parentId fuseops.InodeID, name string) (string, bool) {
+	parent, found := inodes.Load(parentId)
+	if !found {
+		return "", false
+	}
+	return filepath.Join(parent.Path(), name), true
+}
+
+// drops a removed path, along with any of its descendants, from the inodes
+// reached through it, given the links the server reports the file at the path
+// has left. Inodes left without a known link are marked unlinked: they stay
+// known to the kernel until forgotten, but are only reachable through open
+// handles. Those whose file is gone no longer stand for their server file.
+func unlinkPath(inodes *inodeTable, path string, nlink uint64) {
+	inodes.mu.Lock()
+	defer inodes.mu.Unlock()
+	for _, entry := range inodes.byId {
+		dropped, left := entry.DropLinks(path)
+		if !dropped || left {
+			continue
+		}
+		entry.Unlink()
+		// a file with links left keeps its inode, for when it is looked up
+		// again through one of them. Nothing is known of the links left to
+		// descendants, which could only be stale anyway.
+		if nlink > 0 && entry.Path() == path {
+			continue
+		}
+		file := serverFile{entry.Dev(), entry.Ino()}
+		if inodes.byFile[file] == entry {
+			delete(inodes.byFile, file)
+		}
+	}
+}
+
+// moves the inodes of a renamed path, along with any of its descendants,
+// keeping their ids so that open handles remain valid
+func renamePath(inodes *inodeTable, oldPath string, newPath string) {
+	inodes.mu.Lock()
+	defer inodes.mu.Unlock()
+	for _, entry := range inodes.byId {
+		entry.MoveLinks(oldPath, newPath)
+	}
+}
+
+// looks up a named entry under the given parent inode, along with the stats
+// it was found with
+func getOrCreateInode(inodes *inodeTable, fsClient pb.FuseServiceClient, ctx context.Context, parentId fuseops.InodeID, name string) (Inode, fs.FileInfo, error) {
 	log.Print("inode.getOrCreateInode - called. ", name)
 	parent, found := inodes.Load(parentId)
 	if !found {
 		log.Print("inode.getOrCreateInode - no parent Inode: ", parentId)
-		return nil, nil
+		return nil, nil, nil
 	}
-	parentPath := parent.(Inode).Path()
+	parentPath := parent.Path()
 	path := filepath.Join(parentPath, name)
 	log.Print("inode.getOrCreateInode - resolved path: ", path)
 
 	fileInfo, err := getStat(fsClient, ctx, path)
+	if status.Code(err) == codes.NotFound {
+		log.Print("inode.getOrCreateInode - path does not exist: ", path)
+		return nil, nil, nil
+	}
 	if err != nil {
 		log.Print("inode.getOrCreateInode - no path stats: ", path)
-		return nil, err
+		return nil, nil, err
 	}
 	log.Print("inode.getOrCreateInode - got file stats: ", path, fileInfo)
-	// stat, _ := fileInfo.Sys().(*Sys)
+	return storeInode(inodes, fsClient, path, fileInfo), fileInfo, nil
+}
 
-	entry, _ := NewInode(path, fsClient)
-	// entry := &inodeEntry{
-	// 	id:     fuseops.InodeID(stat.Ino),
-	// 	path:   path,
-	// 	client: fsClient,
-	// }
-	storedEntry, _ := inodes.LoadOrStore(entry.Id(), entry)
-	return storedEntry.(Inode), nil
+// registers the inode of a path that is about to be handed to the kernel,
+// reusing the inode already allocated to the same server file, be it reached
+// through a hard link or after a rename. The kernel reference is counted
+// until a matching forget.
+func storeInode(inodes *inodeTable, fsClient pb.FuseServiceClient, path string, fileInfo fs.FileInfo) Inode {
+	stat := fileInfo.Sys().(*Sys)
+	file := serverFile{stat.Dev, stat.Ino}
+	inodes.mu.Lock()
+	defer inodes.mu.Unlock()
+	if entry, found := inodes.byFile[file]; found {
+		// the path just looked up is known to be valid, whereas the one
+		// held by the inode may have been removed behind our back
+		entry.AddLink(path)
+		entry.IncrementLookupCount()
+		return entry
+	}
+	res, found := inodes.reserved[file]
+	id := res.id
+	if found {
+		delete(inodes.reserved, file)
+	} else {
+		id = nextInodeID()
+	}
+	entry, _ := NewInode(id, path, stat.Dev, stat.Ino, fsClient)
+	entry.IncrementLookupCount()
+	inodes.byId[entry.Id()] = entry
+	inodes.byFile[file] = entry
+	return entry
 }
 
 func nextInodeID() (next fuseops.InodeID) {
@@ -66,16 +269,29 @@ func nextInodeID() (next fuseops.InodeID) {
 }
 
 type inodeEntry struct {
-	id     fuseops.InodeID
-	path   string
-	client pb.FuseServiceClient
+	id   fuseops.InodeID
+	dev  uint64
+	ino  uint64
+	mu   sync.RWMutex
+	path string
+	// the paths known to reach the file, path among them
+	links    map[string]struct{}
+	unlinked bool
+	handles  map[fuseops.HandleID]struct{}
+	client   pb.FuseServiceClient
+	// guarded by the lock of the inodeTable holding the entry
+	lookupCount uint64
 }
 
-func NewInode(path string, client pb.FuseServiceClient) (Inode, error) {
+func NewInode(id fuseops.InodeID, path string, dev uint64, ino uint64, client pb.FuseServiceClient) (Inode, error) {
 	return &inodeEntry{
-		id:     nextInodeID(),
-		path:   path,
-		client: client,
+		id:      id,
+		dev:     dev,
+		ino:     ino,
+		path:    path,
+		links:   map[string]struct{}{path: {}},
+		handles: map[fuseops.HandleID]struct{}{},
+		client:  client,
 	}, nil
 }
 
@@ -83,45 +299,156 @@ func (in *inodeEntry) Id() fuseops.InodeID {
 	return in.id
 }
 
+func (in *inodeEntry) Dev() uint64 {
+	return in.dev
+}
+
+func (in *inodeEntry) Ino() uint64 {
+	return in.ino
+}
+
 func (in *inodeEntry) Path() string {
+	in.mu.RLock()
+	defer in.mu.RUnlock()
 	return in.path
 }
 
+// records a path found to reach the file, and uses it from then on
+func (in *inodeEntry) AddLink(path string) {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	in.path = path
+	in.links[path] = struct{}{}
+	in.unlinked = false
+}
+
+// forgets the links at or beneath a removed path, switching to another known
+// link if needed. Reports whether any link was dropped, and whether the inode
+// has any known link left.
+func (in *inodeEntry) DropLinks(path string) (dropped bool, left bool) {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	for link := range in.links {
+		if link == path || strings.HasPrefix(link, path+"/") {
+			delete(in.links, link)
+			dropped = true
+		}
+	}
+	if _, found := in.links[in.path]; !found {
+		for link := range in.links {
+			in.path = link
+			break
+		}
+	}
+	return dropped, len(in.links) > 0
+}
+
+// moves the links at or beneath a renamed path
+func (in *inodeEntry) MoveLinks(oldPath string, newPath string) {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	moved := map[string]struct{}{}
+	for link := range in.links {
+		if link == oldPath || strings.HasPrefix(link, oldPath+"/") {
+			delete(in.links, link)
+			moved[newPath+strings.TrimPrefix(link, oldPath)] = struct{}{}
+		}
+	}
+	for link := range moved {
+		in.links[link] = struct{}{}
+	}
+	if in.path == oldPath || strings.HasPrefix(in.path, oldPath+"/") {
+		in.path = newPath + strings.TrimPrefix(in.path, oldPath)
+	}
+}
+
+func (in *inodeEntry) Unlink() {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	in.unlinked = true
+}
+
+func (in *inodeEntry) AddHandle(handle fuseops.HandleID) {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	in.handles[handle] = struct{}{}
+}
+
+func (in *inodeEntry) RemoveHandle(handle fuseops.HandleID) {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	delete(in.handles, handle)
+}
+
+func (in *inodeEntry) IncrementLookupCount() {
+	in.lookupCount++
+}
+
+func (in *inodeEntry) DecrementLookupCount(n uint64) uint64 {
+	if n > in.lookupCount {
+		n = in.lookupCount
+	}
+	in.lookupCount -= n
+	return in.lookupCount
+}
+
 func (in *inodeEntry) String() string {
-	return fmt.Sprintf("%v::%v", in.id, in.path)
+	return fmt.Sprintf("%v::%v", in.id, in.Path())
 }
 
 func (in *inodeEntry) Attributes() (*fuseops.InodeAttributes, error) {
-	log.Print("inodeEntry.Attributes - called. ", in.path)
-	fileInfo, err := getStat(in.client, context.TODO(), in.path)
+	log.Print("inodeEntry.Attributes - called. ", in.Path())
+	in.mu.RLock()
+	path, unlinked := in.path, in.unlinked
+	var handle fuseops.HandleID
+	for handle = range in.handles {
+		break
+	}
+	in.mu.RUnlock()
+
+	var fileInfo fs.FileInfo
+	var err error
+	if !unlinked {
+		fileInfo, err = getStat(in.client, context.TODO(), path)
+	} else if handle != 0 {
+		// the path is gone, but the file is still held open on the server
+		fileInfo, err = statHandle(in.client, context.TODO(), uint64(handle))
+	} else {
+		err = fuse.ENOENT
+	}
 	if err != nil {
 		return &fuseops.InodeAttributes{}, err
 	}
+	return attributesOf(fileInfo), nil
+}
 
+func attributesOf(fileInfo fs.FileInfo) *fuseops.InodeAttributes {
 	return &fuseops.InodeAttributes{
 		Size:  uint64(fileInfo.Size()),
-		Nlink: 1,
+		Nlink: uint32(fileInfo.Sys().(*Sys).Nlink),
 		Mode:  fileInfo.Mode(),
 		Mtime: fileInfo.ModTime(),
 		Uid:   uid,
 		Gid:   gid,
-	}, nil
+	}
 }
 
-func (in *inodeEntry) ListChildren(inodes *sync.Map) ([]*fuseutil.Dirent, error) {
-	log.Print("inodeEntry.ListChildren - called. ", in.path)
-	children, err := readDir(in.client, context.TODO(), in.path)
+func (in *inodeEntry) ListChildren(inodes *inodeTable, handle fuseops.HandleID) ([]*fuseutil.Dirent, error) {
+	log.Print("inodeEntry.ListChildren - called. ", in.Path(), handle)
+	children, err := readDir(in.client, context.TODO(), in.Path(), uint64(handle))
 	if err != nil {
-		log.Print("inodeEntry.ListChildren - error in readDir. ", in.path)
+		log.Print("inodeEntry.ListChildren - error in readDir. ", in.Path())
 		return nil, err
 	}
 	dirents := []*fuseutil.Dirent{}
 	for i, child := range children {
 
-		childInode, err := getOrCreateInode(inodes, in.client, context.TODO(), in.id, child.Name())
-		if err != nil || childInode == nil {
-			continue
-		}
+		// listing a directory hands no references to the kernel, so children
+		// get no inodes of their own until they are looked up, only the id
+		// they will be looked up under
+		info, _ := child.Info()
+		stat := info.Sys().(*Sys)
+		childId := inodes.idFor(in.id, serverFile{stat.Dev, stat.Ino})
 
 		var childType fuseutil.DirentType
 		if child.IsDir() {
@@ -134,16 +461,10 @@ func (in *inodeEntry) ListChildren(inodes *sync.Map) ([]*fuseutil.Dirent, error)
 
 		dirents = append(dirents, &fuseutil.Dirent{
 			Offset: fuseops.DirOffset(i + 1),
-			Inode:  childInode.Id(),
+			Inode:  childId,
 			Name:   child.Name(),
 			Type:   childType,
 		})
 	}
 	return dirents, nil
 }
-
-func (in *inodeEntry) Contents() ([]byte, error) {
-	log.Print("inodeEntry.Contents - called. ", in.path)
-	res, err := readFile(in.client, context.TODO(), in.path)
-	return res, err
-}
@@ -30,6 +30,7 @@ type RPCContext struct {
 	GatewayId   string `protobuf:"bytes,1,opt,name=GatewayId,proto3" json:"GatewayId,omitempty"`
 	AccessToken string `protobuf:"bytes,2,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
 	AgentId     string `protobuf:"bytes,3,opt,name=AgentId,proto3" json:"AgentId,omitempty"`
+	Export      string `protobuf:"bytes,4,opt,name=Export,proto3" json:"Export,omitempty"`
 }
 
 func (x *RPCContext) Reset() {
@@ -85,6 +86,13 @@ func (x *RPCContext) GetAgentId() string {
 	return ""
 }
 
+func (x *RPCContext) GetExport() string {
+	if x != nil {
+		return x.Export
+	}
+	return ""
+}
+
 // Primitive
 type OpContext struct {
 	state         protoimpl.MessageState
@@ -256,6 +264,8 @@ type FileInfo struct {
 	ModTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ModTime,proto3" json:"ModTime,omitempty"`
 	IsDir   bool                   `protobuf:"varint,5,opt,name=IsDir,proto3" json:"IsDir,omitempty"`
 	Ino     uint64                 `protobuf:"varint,6,opt,name=Ino,proto3" json:"Ino,omitempty"`
+	Nlink   uint64                 `protobuf:"varint,7,opt,name=Nlink,proto3" json:"Nlink,omitempty"`
+	Dev     uint64                 `protobuf:"varint,8,opt,name=Dev,proto3" json:"Dev,omitempty"`
 }
 
 func (x *FileInfo) Reset() {
@@ -332,6 +342,20 @@ func (x *FileInfo) GetIno() uint64 {
 	return 0
 }
 
+func (x *FileInfo) GetNlink() uint64 {
+	if x != nil {
+		return x.Nlink
+	}
+	return 0
+}
+
+func (x *FileInfo) GetDev() uint64 {
+	if x != nil {
+		return x.Dev
+	}
+	return 0
+}
+
 type OpenedDir struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -759,7 +783,109 @@ func (x *InodeAtt) GetCtime() *timestamppb.Timestamp {
 	return nil
 }
 
+type ExportInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	ReadOnly bool   `protobuf:"varint,2,opt,name=ReadOnly,proto3" json:"ReadOnly,omitempty"`
+}
+
+func (x *ExportInfo) Reset() {
+	*x = ExportInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_grpcfs_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportInfo) ProtoMessage() {}
+
+func (x *ExportInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportInfo.ProtoReflect.Descriptor instead.
+func (*ExportInfo) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ExportInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExportInfo) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
 // Request Bodies
+type ListExportsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Context *RPCContext `protobuf:"bytes,1,opt,name=Context,proto3" json:"Context,omitempty"`
+}
+
+func (x *ListExportsReq) Reset() {
+	*x = ListExportsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_grpcfs_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExportsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExportsReq) ProtoMessage() {}
+
+func (x *ListExportsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExportsReq.ProtoReflect.Descriptor instead.
+func (*ListExportsReq) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListExportsReq) GetContext() *RPCContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
 type StatFsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -772,7 +898,7 @@ type StatFsReq struct {
 func (x *StatFsReq) Reset() {
 	*x = StatFsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_grpcfs_proto_msgTypes[9]
+		mi := &file_proto_grpcfs_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -785,7 +911,7 @@ func (x *StatFsReq) String() string {
 func (*StatFsReq) ProtoMessage() {}
 
 func (x *StatFsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpcfs_proto_msgTypes[9]
+	mi := &file_proto_grpcfs_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -798,7 +924,7 @@ func (x *StatFsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatFsReq.ProtoReflect.Descriptor instead.
 func (*StatFsReq) Descriptor() ([]byte, []int) {
-	return file_proto_grpcfs_proto_rawDescGZIP(), []int{9}
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *StatFsReq) GetName() string {
@@ -822,12 +948,13 @@ type FileInfoReq struct {
 
 	Name    string      `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
 	Context *RPCContext `protobuf:"bytes,2,opt,name=Context,proto3" json:"Context,omitempty"`
+	Handle  uint64      `protobuf:"varint,3,opt,name=Handle,proto3" json:"Handle,omitempty"`
 }
 
 func (x *FileInfoReq) Reset() {
 	*x = FileInfoReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_grpcfs_proto_msgTypes[10]
+		mi := &file_proto_grpcfs_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -840,7 +967,7 @@ func (x *FileInfoReq) String() string {
 func (*FileInfoReq) ProtoMessage() {}
 
 func (x *FileInfoReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpcfs_proto_msgTypes[10]
+	mi := &file_proto_grpcfs_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -853,7 +980,7 @@ func (x *FileInfoReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileInfoReq.ProtoReflect.Descriptor instead.
 func (*FileInfoReq) Descriptor() ([]byte, []int) {
-	return file_proto_grpcfs_proto_rawDescGZIP(), []int{10}
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *FileInfoReq) GetName() string {
@@ -870,6 +997,13 @@ func (x *FileInfoReq) GetContext() *RPCContext {
 	return nil
 }
 
+func (x *FileInfoReq) GetHandle() uint64 {
+	if x != nil {
+		return x.Handle
+	}
+	return 0
+}
+
 type OpenDirReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -882,7 +1016,7 @@ type OpenDirReq struct {
 func (x *OpenDirReq) Reset() {
 	*x = OpenDirReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_grpcfs_proto_msgTypes[11]
+		mi := &file_proto_grpcfs_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -895,7 +1029,7 @@ func (x *OpenDirReq) String() string {
 func (*OpenDirReq) ProtoMessage() {}
 
 func (x *OpenDirReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpcfs_proto_msgTypes[11]
+	mi := &file_proto_grpcfs_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -908,7 +1042,7 @@ func (x *OpenDirReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OpenDirReq.ProtoReflect.Descriptor instead.
 func (*OpenDirReq) Descriptor() ([]byte, []int) {
-	return file_proto_grpcfs_proto_rawDescGZIP(), []int{11}
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *OpenDirReq) GetName() string {
@@ -932,12 +1066,13 @@ type OpenFileReq struct {
 
 	Name    string      `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
 	Context *RPCContext `protobuf:"bytes,2,opt,name=Context,proto3" json:"Context,omitempty"`
+	Flags   uint32      `protobuf:"varint,3,opt,name=Flags,proto3" json:"Flags,omitempty"`
 }
 
 func (x *OpenFileReq) Reset() {
 	*x = OpenFileReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_grpcfs_proto_msgTypes[12]
+		mi := &file_proto_grpcfs_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -950,7 +1085,7 @@ func (x *OpenFileReq) String() string {
 func (*OpenFileReq) ProtoMessage() {}
 
 func (x *OpenFileReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpcfs_proto_msgTypes[12]
+	mi := &file_proto_grpcfs_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -963,7 +1098,7 @@ func (x *OpenFileReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OpenFileReq.ProtoReflect.Descriptor instead.
 func (*OpenFileReq) Descriptor() ([]byte, []int) {
-	return file_proto_grpcfs_proto_rawDescGZIP(), []int{12}
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *OpenFileReq) GetName() string {
@@ -980,6 +1115,13 @@ func (x *OpenFileReq) GetContext() *RPCContext {
 	return nil
 }
 
+func (x *OpenFileReq) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
 type ReadDirReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -987,12 +1129,13 @@ type ReadDirReq struct {
 
 	Name    string      `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
 	Context *RPCContext `protobuf:"bytes,2,opt,name=Context,proto3" json:"Context,omitempty"`
+	Handle  uint64      `protobuf:"varint,3,opt,name=Handle,proto3" json:"Handle,omitempty"`
 }
 
 func (x *ReadDirReq) Reset() {
 	*x = ReadDirReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_grpcfs_proto_msgTypes[13]
+		mi := &file_proto_grpcfs_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1005,7 +1148,7 @@ func (x *ReadDirReq) String() string {
 func (*ReadDirReq) ProtoMessage() {}
 
 func (x *ReadDirReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpcfs_proto_msgTypes[13]
+	mi := &file_proto_grpcfs_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1018,7 +1161,7 @@ func (x *ReadDirReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadDirReq.ProtoReflect.Descriptor instead.
 func (*ReadDirReq) Descriptor() ([]byte, []int) {
-	return file_proto_grpcfs_proto_rawDescGZIP(), []int{13}
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ReadDirReq) GetName() string {
@@ -1035,19 +1178,30 @@ func (x *ReadDirReq) GetContext() *RPCContext {
 	return nil
 }
 
+func (x *ReadDirReq) GetHandle() uint64 {
+	if x != nil {
+		return x.Handle
+	}
+	return 0
+}
+
 type ReadFileReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string      `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Context *RPCContext `protobuf:"bytes,2,opt,name=Context,proto3" json:"Context,omitempty"`
+	Name      string      `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Context   *RPCContext `protobuf:"bytes,2,opt,name=Context,proto3" json:"Context,omitempty"`
+	Offset    int64       `protobuf:"varint,3,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	Size      int64       `protobuf:"varint,4,opt,name=Size,proto3" json:"Size,omitempty"`
+	Handle    uint64      `protobuf:"varint,5,opt,name=Handle,proto3" json:"Handle,omitempty"`
+	ChunkSize int64       `protobuf:"varint,6,opt,name=ChunkSize,proto3" json:"ChunkSize,omitempty"`
 }
 
 func (x *ReadFileReq) Reset() {
 	*x = ReadFileReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_grpcfs_proto_msgTypes[14]
+		mi := &file_proto_grpcfs_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1060,7 +1214,7 @@ func (x *ReadFileReq) String() string {
 func (*ReadFileReq) ProtoMessage() {}
 
 func (x *ReadFileReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpcfs_proto_msgTypes[14]
+	mi := &file_proto_grpcfs_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1073,7 +1227,7 @@ func (x *ReadFileReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadFileReq.ProtoReflect.Descriptor instead.
 func (*ReadFileReq) Descriptor() ([]byte, []int) {
-	return file_proto_grpcfs_proto_rawDescGZIP(), []int{14}
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ReadFileReq) GetName() string {
@@ -1090,6 +1244,34 @@ func (x *ReadFileReq) GetContext() *RPCContext {
 	return nil
 }
 
+func (x *ReadFileReq) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ReadFileReq) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ReadFileReq) GetHandle() uint64 {
+	if x != nil {
+		return x.Handle
+	}
+	return 0
+}
+
+func (x *ReadFileReq) GetChunkSize() int64 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
 type WriteFileReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1099,12 +1281,13 @@ type WriteFileReq struct {
 	Context *RPCContext `protobuf:"bytes,2,opt,name=Context,proto3" json:"Context,omitempty"`
 	Data    []byte      `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
 	Offset  int64       `protobuf:"varint,4,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	Handle  uint64      `protobuf:"varint,5,opt,name=Handle,proto3" json:"Handle,omitempty"`
 }
 
 func (x *WriteFileReq) Reset() {
 	*x = WriteFileReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_grpcfs_proto_msgTypes[15]
+		mi := &file_proto_grpcfs_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1117,7 +1300,7 @@ func (x *WriteFileReq) String() string {
 func (*WriteFileReq) ProtoMessage() {}
 
 func (x *WriteFileReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpcfs_proto_msgTypes[15]
+	mi := &file_proto_grpcfs_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1130,7 +1313,7 @@ func (x *WriteFileReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WriteFileReq.ProtoReflect.Descriptor instead.
 func (*WriteFileReq) Descriptor() ([]byte, []int) {
-	return file_proto_grpcfs_proto_rawDescGZIP(), []int{15}
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *WriteFileReq) GetName() string {
@@ -1161,36 +1344,40 @@ func (x *WriteFileReq) GetOffset() int64 {
 	return 0
 }
 
-type SetInodeAttReq struct {
+func (x *WriteFileReq) GetHandle() uint64 {
+	if x != nil {
+		return x.Handle
+	}
+	return 0
+}
+
+type CreateFileReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Context  *RPCContext            `protobuf:"bytes,2,opt,name=Context,proto3" json:"Context,omitempty"`
-	Size     *uint64                `protobuf:"varint,3,opt,name=Size,proto3,oneof" json:"Size,omitempty"`
-	FileMode *uint32                `protobuf:"varint,4,opt,name=FileMode,proto3,oneof" json:"FileMode,omitempty"`
-	ATime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ATime,proto3,oneof" json:"ATime,omitempty"`
-	MTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=MTime,proto3,oneof" json:"MTime,omitempty"`
+	Name    string      `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Context *RPCContext `protobuf:"bytes,2,opt,name=Context,proto3" json:"Context,omitempty"`
+	Mode    uint32      `protobuf:"varint,3,opt,name=Mode,proto3" json:"Mode,omitempty"`
 }
 
-func (x *SetInodeAttReq) Reset() {
-	*x = SetInodeAttReq{}
+func (x *CreateFileReq) Reset() {
+	*x = CreateFileReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_grpcfs_proto_msgTypes[16]
+		mi := &file_proto_grpcfs_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetInodeAttReq) String() string {
+func (x *CreateFileReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetInodeAttReq) ProtoMessage() {}
+func (*CreateFileReq) ProtoMessage() {}
 
-func (x *SetInodeAttReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpcfs_proto_msgTypes[16]
+func (x *CreateFileReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1201,79 +1388,59 @@ func (x *SetInodeAttReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetInodeAttReq.ProtoReflect.Descriptor instead.
-func (*SetInodeAttReq) Descriptor() ([]byte, []int) {
-	return file_proto_grpcfs_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use CreateFileReq.ProtoReflect.Descriptor instead.
+func (*CreateFileReq) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *SetInodeAttReq) GetName() string {
+func (x *CreateFileReq) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *SetInodeAttReq) GetContext() *RPCContext {
+func (x *CreateFileReq) GetContext() *RPCContext {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *SetInodeAttReq) GetSize() uint64 {
-	if x != nil && x.Size != nil {
-		return *x.Size
-	}
-	return 0
-}
-
-func (x *SetInodeAttReq) GetFileMode() uint32 {
-	if x != nil && x.FileMode != nil {
-		return *x.FileMode
-	}
-	return 0
-}
-
-func (x *SetInodeAttReq) GetATime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ATime
-	}
-	return nil
-}
-
-func (x *SetInodeAttReq) GetMTime() *timestamppb.Timestamp {
+func (x *CreateFileReq) GetMode() uint32 {
 	if x != nil {
-		return x.MTime
+		return x.Mode
 	}
-	return nil
+	return 0
 }
 
-// Response Bodies
-type StatFsRes struct {
+type MkDirReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Result *StatFs `protobuf:"bytes,1,opt,name=Result,proto3" json:"Result,omitempty"`
+	Name    string      `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Context *RPCContext `protobuf:"bytes,2,opt,name=Context,proto3" json:"Context,omitempty"`
+	Mode    uint32      `protobuf:"varint,3,opt,name=Mode,proto3" json:"Mode,omitempty"`
 }
 
-func (x *StatFsRes) Reset() {
-	*x = StatFsRes{}
+func (x *MkDirReq) Reset() {
+	*x = MkDirReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_grpcfs_proto_msgTypes[17]
+		mi := &file_proto_grpcfs_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StatFsRes) String() string {
+func (x *MkDirReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatFsRes) ProtoMessage() {}
+func (*MkDirReq) ProtoMessage() {}
 
-func (x *StatFsRes) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpcfs_proto_msgTypes[17]
+func (x *MkDirReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1284,19 +1451,1593 @@ func (x *StatFsRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatFsRes.ProtoReflect.Descriptor instead.
-func (*StatFsRes) Descriptor() ([]byte, []int) {
-	return file_proto_grpcfs_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use MkDirReq.ProtoReflect.Descriptor instead.
+func (*MkDirReq) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *StatFsRes) GetResult() *StatFs {
+func (x *MkDirReq) GetName() string {
 	if x != nil {
-		return x.Result
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MkDirReq) GetContext() *RPCContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *MkDirReq) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+type RmDirReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string      `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Context *RPCContext `protobuf:"bytes,2,opt,name=Context,proto3" json:"Context,omitempty"`
+}
+
+func (x *RmDirReq) Reset() {
+	*x = RmDirReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_grpcfs_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RmDirReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RmDirReq) ProtoMessage() {}
+
+func (x *RmDirReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RmDirReq.ProtoReflect.Descriptor instead.
+func (*RmDirReq) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RmDirReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RmDirReq) GetContext() *RPCContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type UnlinkReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string      `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Context *RPCContext `protobuf:"bytes,2,opt,name=Context,proto3" json:"Context,omitempty"`
+}
+
+func (x *UnlinkReq) Reset() {
+	*x = UnlinkReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_grpcfs_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlinkReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkReq) ProtoMessage() {}
+
+func (x *UnlinkReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkReq.ProtoReflect.Descriptor instead.
+func (*UnlinkReq) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UnlinkReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UnlinkReq) GetContext() *RPCContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type RenameReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string      `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Context *RPCContext `protobuf:"bytes,2,opt,name=Context,proto3" json:"Context,omitempty"`
+	NewName string      `protobuf:"bytes,3,opt,name=NewName,proto3" json:"NewName,omitempty"`
+}
+
+func (x *RenameReq) Reset() {
+	*x = RenameReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_grpcfs_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameReq) ProtoMessage() {}
+
+func (x *RenameReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameReq.ProtoReflect.Descriptor instead.
+func (*RenameReq) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RenameReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RenameReq) GetContext() *RPCContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *RenameReq) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+type ReadLinkReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string      `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Context *RPCContext `protobuf:"bytes,2,opt,name=Context,proto3" json:"Context,omitempty"`
+}
+
+func (x *ReadLinkReq) Reset() {
+	*x = ReadLinkReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_grpcfs_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadLinkReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadLinkReq) ProtoMessage() {}
+
+func (x *ReadLinkReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadLinkReq.ProtoReflect.Descriptor instead.
+func (*ReadLinkReq) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReadLinkReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReadLinkReq) GetContext() *RPCContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type SymlinkReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string      `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Context *RPCContext `protobuf:"bytes,2,opt,name=Context,proto3" json:"Context,omitempty"`
+	Target  string      `protobuf:"bytes,3,opt,name=Target,proto3" json:"Target,omitempty"`
+}
+
+func (x *SymlinkReq) Reset() {
+	*x = SymlinkReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_grpcfs_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SymlinkReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SymlinkReq) ProtoMessage() {}
+
+func (x *SymlinkReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SymlinkReq.ProtoReflect.Descriptor instead.
+func (*SymlinkReq) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SymlinkReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SymlinkReq) GetContext() *RPCContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *SymlinkReq) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+type LinkReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string      `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Context *RPCContext `protobuf:"bytes,2,opt,name=Context,proto3" json:"Context,omitempty"`
+	Target  string      `protobuf:"bytes,3,opt,name=Target,proto3" json:"Target,omitempty"`
+}
+
+func (x *LinkReq) Reset() {
+	*x = LinkReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_grpcfs_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinkReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkReq) ProtoMessage() {}
+
+func (x *LinkReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkReq.ProtoReflect.Descriptor instead.
+func (*LinkReq) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *LinkReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LinkReq) GetContext() *RPCContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *LinkReq) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+type GetXattrReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string      `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Context *RPCContext `protobuf:"bytes,2,opt,name=Context,proto3" json:"Context,omitempty"`
+	Attr    string      `protobuf:"bytes,3,opt,name=Attr,proto3" json:"Attr,omitempty"`
+}
+
+func (x *GetXattrReq) Reset() {
+	*x = GetXattrReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_grpcfs_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetXattrReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetXattrReq) ProtoMessage() {}
+
+func (x *GetXattrReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetXattrReq.ProtoReflect.Descriptor instead.
+func (*GetXattrReq) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetXattrReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetXattrReq) GetContext() *RPCContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetXattrReq) GetAttr() string {
+	if x != nil {
+		return x.Attr
+	}
+	return ""
+}
+
+type ListXattrReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string      `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Context *RPCContext `protobuf:"bytes,2,opt,name=Context,proto3" json:"Context,omitempty"`
+}
+
+func (x *ListXattrReq) Reset() {
+	*x = ListXattrReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_grpcfs_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListXattrReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListXattrReq) ProtoMessage() {}
+
+func (x *ListXattrReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListXattrReq.ProtoReflect.Descriptor instead.
+func (*ListXattrReq) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListXattrReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListXattrReq) GetContext() *RPCContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type SetXattrReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string      `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Context *RPCContext `protobuf:"bytes,2,opt,name=Context,proto3" json:"Context,omitempty"`
+	Attr    string      `protobuf:"bytes,3,opt,name=Attr,proto3" json:"Attr,omitempty"`
+	Value   []byte      `protobuf:"bytes,4,opt,name=Value,proto3" json:"Value,omitempty"`
+	Flags   uint32      `protobuf:"varint,5,opt,name=Flags,proto3" json:"Flags,omitempty"`
+}
+
+func (x *SetXattrReq) Reset() {
+	*x = SetXattrReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_grpcfs_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetXattrReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetXattrReq) ProtoMessage() {}
+
+func (x *SetXattrReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetXattrReq.ProtoReflect.Descriptor instead.
+func (*SetXattrReq) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetXattrReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetXattrReq) GetContext() *RPCContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *SetXattrReq) GetAttr() string {
+	if x != nil {
+		return x.Attr
+	}
+	return ""
+}
+
+func (x *SetXattrReq) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *SetXattrReq) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type RemoveXattrReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string      `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Context *RPCContext `protobuf:"bytes,2,opt,name=Context,proto3" json:"Context,omitempty"`
+	Attr    string      `protobuf:"bytes,3,opt,name=Attr,proto3" json:"Attr,omitempty"`
+}
+
+func (x *RemoveXattrReq) Reset() {
+	*x = RemoveXattrReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_grpcfs_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveXattrReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveXattrReq) ProtoMessage() {}
+
+func (x *RemoveXattrReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveXattrReq.ProtoReflect.Descriptor instead.
+func (*RemoveXattrReq) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RemoveXattrReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RemoveXattrReq) GetContext() *RPCContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *RemoveXattrReq) GetAttr() string {
+	if x != nil {
+		return x.Attr
+	}
+	return ""
+}
+
+type ReleaseHandleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Handle  uint64      `protobuf:"varint,1,opt,name=Handle,proto3" json:"Handle,omitempty"`
+	Context *RPCContext `protobuf:"bytes,2,opt,name=Context,proto3" json:"Context,omitempty"`
+}
+
+func (x *ReleaseHandleReq) Reset() {
+	*x = ReleaseHandleReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_grpcfs_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseHandleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseHandleReq) ProtoMessage() {}
+
+func (x *ReleaseHandleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseHandleReq.ProtoReflect.Descriptor instead.
+func (*ReleaseHandleReq) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ReleaseHandleReq) GetHandle() uint64 {
+	if x != nil {
+		return x.Handle
+	}
+	return 0
+}
+
+func (x *ReleaseHandleReq) GetContext() *RPCContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type SetInodeAttReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Context  *RPCContext            `protobuf:"bytes,2,opt,name=Context,proto3" json:"Context,omitempty"`
+	Size     *uint64                `protobuf:"varint,3,opt,name=Size,proto3,oneof" json:"Size,omitempty"`
+	FileMode *uint32                `protobuf:"varint,4,opt,name=FileMode,proto3,oneof" json:"FileMode,omitempty"`
+	ATime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ATime,proto3,oneof" json:"ATime,omitempty"`
+	MTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=MTime,proto3,oneof" json:"MTime,omitempty"`
+	Handle   uint64                 `protobuf:"varint,7,opt,name=Handle,proto3" json:"Handle,omitempty"`
+}
+
+func (x *SetInodeAttReq) Reset() {
+	*x = SetInodeAttReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_grpcfs_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetInodeAttReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInodeAttReq) ProtoMessage() {}
+
+func (x *SetInodeAttReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInodeAttReq.ProtoReflect.Descriptor instead.
+func (*SetInodeAttReq) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SetInodeAttReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetInodeAttReq) GetContext() *RPCContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *SetInodeAttReq) GetSize() uint64 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+func (x *SetInodeAttReq) GetFileMode() uint32 {
+	if x != nil && x.FileMode != nil {
+		return *x.FileMode
+	}
+	return 0
+}
+
+func (x *SetInodeAttReq) GetATime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ATime
+	}
+	return nil
+}
+
+func (x *SetInodeAttReq) GetMTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MTime
+	}
+	return nil
+}
+
+func (x *SetInodeAttReq) GetHandle() uint64 {
+	if x != nil {
+		return x.Handle
+	}
+	return 0
+}
+
+// Response Bodies
+type ListExportsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result []*ExportInfo `protobuf:"bytes,1,rep,name=Result,proto3" json:"Result,omitempty"`
+}
+
+func (x *ListExportsRes) Reset() {
+	*x = ListExportsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_grpcfs_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExportsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExportsRes) ProtoMessage() {}
+
+func (x *ListExportsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExportsRes.ProtoReflect.Descriptor instead.
+func (*ListExportsRes) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListExportsRes) GetResult() []*ExportInfo {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type StatFsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *StatFs `protobuf:"bytes,1,opt,name=Result,proto3" json:"Result,omitempty"`
+}
+
+func (x *StatFsRes) Reset() {
+	*x = StatFsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_grpcfs_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatFsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatFsRes) ProtoMessage() {}
+
+func (x *StatFsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatFsRes.ProtoReflect.Descriptor instead.
+func (*StatFsRes) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *StatFsRes) GetResult() *StatFs {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type FileInfoRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *FileInfo `protobuf:"bytes,1,opt,name=Result,proto3" json:"Result,omitempty"`
+}
+
+func (x *FileInfoRes) Reset() {
+	*x = FileInfoRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_grpcfs_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileInfoRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileInfoRes) ProtoMessage() {}
+
+func (x *FileInfoRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileInfoRes.ProtoReflect.Descriptor instead.
+func (*FileInfoRes) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FileInfoRes) GetResult() *FileInfo {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type OpenDirRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *OpenedDir `protobuf:"bytes,1,opt,name=Result,proto3" json:"Result,omitempty"`
+}
+
+func (x *OpenDirRes) Reset() {
+	*x = OpenDirRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_grpcfs_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenDirRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenDirRes) ProtoMessage() {}
+
+func (x *OpenDirRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenDirRes.ProtoReflect.Descriptor instead.
+func (*OpenDirRes) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *OpenDirRes) GetResult() *OpenedDir {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type OpenFileRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *OpenedFile `protobuf:"bytes,1,opt,name=Result,proto3" json:"Result,omitempty"`
+}
+
+func (x *OpenFileRes) Reset() {
+	*x = OpenFileRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_grpcfs_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenFileRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenFileRes) ProtoMessage() {}
+
+func (x *OpenFileRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenFileRes.ProtoReflect.Descriptor instead.
+func (*OpenFileRes) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *OpenFileRes) GetResult() *OpenedFile {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type ReadDirRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result []*DirEntry `protobuf:"bytes,1,rep,name=Result,proto3" json:"Result,omitempty"`
+}
+
+func (x *ReadDirRes) Reset() {
+	*x = ReadDirRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_grpcfs_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadDirRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadDirRes) ProtoMessage() {}
+
+func (x *ReadDirRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadDirRes.ProtoReflect.Descriptor instead.
+func (*ReadDirRes) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ReadDirRes) GetResult() []*DirEntry {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type ReadFileRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *FileEntry `protobuf:"bytes,1,opt,name=Result,proto3" json:"Result,omitempty"`
+}
+
+func (x *ReadFileRes) Reset() {
+	*x = ReadFileRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_grpcfs_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadFileRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadFileRes) ProtoMessage() {}
+
+func (x *ReadFileRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadFileRes.ProtoReflect.Descriptor instead.
+func (*ReadFileRes) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ReadFileRes) GetResult() *FileEntry {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type WriteFileRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result       bool  `protobuf:"varint,1,opt,name=Result,proto3" json:"Result,omitempty"`
+	BytesWritten int64 `protobuf:"varint,2,opt,name=BytesWritten,proto3" json:"BytesWritten,omitempty"`
+}
+
+func (x *WriteFileRes) Reset() {
+	*x = WriteFileRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_grpcfs_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteFileRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteFileRes) ProtoMessage() {}
+
+func (x *WriteFileRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteFileRes.ProtoReflect.Descriptor instead.
+func (*WriteFileRes) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *WriteFileRes) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *WriteFileRes) GetBytesWritten() int64 {
+	if x != nil {
+		return x.BytesWritten
+	}
+	return 0
+}
+
+// acknowledges a streamed write, up to the offset committed so far
+type WriteFileAck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset       int64 `protobuf:"varint,1,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	BytesWritten int64 `protobuf:"varint,2,opt,name=BytesWritten,proto3" json:"BytesWritten,omitempty"`
+}
+
+func (x *WriteFileAck) Reset() {
+	*x = WriteFileAck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_grpcfs_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteFileAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteFileAck) ProtoMessage() {}
+
+func (x *WriteFileAck) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteFileAck.ProtoReflect.Descriptor instead.
+func (*WriteFileAck) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *WriteFileAck) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *WriteFileAck) GetBytesWritten() int64 {
+	if x != nil {
+		return x.BytesWritten
+	}
+	return 0
+}
+
+type CreateFileRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *FileInfo `protobuf:"bytes,1,opt,name=Result,proto3" json:"Result,omitempty"`
+	Handle uint64    `protobuf:"varint,2,opt,name=Handle,proto3" json:"Handle,omitempty"`
+}
+
+func (x *CreateFileRes) Reset() {
+	*x = CreateFileRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_grpcfs_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFileRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFileRes) ProtoMessage() {}
+
+func (x *CreateFileRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFileRes.ProtoReflect.Descriptor instead.
+func (*CreateFileRes) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CreateFileRes) GetResult() *FileInfo {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *CreateFileRes) GetHandle() uint64 {
+	if x != nil {
+		return x.Handle
+	}
+	return 0
+}
+
+type MkDirRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *FileInfo `protobuf:"bytes,1,opt,name=Result,proto3" json:"Result,omitempty"`
+}
+
+func (x *MkDirRes) Reset() {
+	*x = MkDirRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_grpcfs_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MkDirRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MkDirRes) ProtoMessage() {}
+
+func (x *MkDirRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MkDirRes.ProtoReflect.Descriptor instead.
+func (*MkDirRes) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *MkDirRes) GetResult() *FileInfo {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type RmDirRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result bool `protobuf:"varint,1,opt,name=Result,proto3" json:"Result,omitempty"`
+}
+
+func (x *RmDirRes) Reset() {
+	*x = RmDirRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_grpcfs_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RmDirRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RmDirRes) ProtoMessage() {}
+
+func (x *RmDirRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RmDirRes.ProtoReflect.Descriptor instead.
+func (*RmDirRes) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RmDirRes) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+// the links the file has left, with which it lives on
+type UnlinkRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result bool   `protobuf:"varint,1,opt,name=Result,proto3" json:"Result,omitempty"`
+	Nlink  uint64 `protobuf:"varint,2,opt,name=Nlink,proto3" json:"Nlink,omitempty"`
+}
+
+func (x *UnlinkRes) Reset() {
+	*x = UnlinkRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_grpcfs_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlinkRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkRes) ProtoMessage() {}
+
+func (x *UnlinkRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkRes.ProtoReflect.Descriptor instead.
+func (*UnlinkRes) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UnlinkRes) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *UnlinkRes) GetNlink() uint64 {
+	if x != nil {
+		return x.Nlink
+	}
+	return 0
+}
+
+// the links left to the file replaced at the new name, if any
+type RenameRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result bool   `protobuf:"varint,1,opt,name=Result,proto3" json:"Result,omitempty"`
+	Nlink  uint64 `protobuf:"varint,2,opt,name=Nlink,proto3" json:"Nlink,omitempty"`
+}
+
+func (x *RenameRes) Reset() {
+	*x = RenameRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_grpcfs_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameRes) ProtoMessage() {}
+
+func (x *RenameRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameRes.ProtoReflect.Descriptor instead.
+func (*RenameRes) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RenameRes) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *RenameRes) GetNlink() uint64 {
+	if x != nil {
+		return x.Nlink
+	}
+	return 0
+}
+
+type ReadLinkRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=Result,proto3" json:"Result,omitempty"`
+}
+
+func (x *ReadLinkRes) Reset() {
+	*x = ReadLinkRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_grpcfs_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadLinkRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadLinkRes) ProtoMessage() {}
+
+func (x *ReadLinkRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadLinkRes.ProtoReflect.Descriptor instead.
+func (*ReadLinkRes) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ReadLinkRes) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type SymlinkRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *FileInfo `protobuf:"bytes,1,opt,name=Result,proto3" json:"Result,omitempty"`
+}
+
+func (x *SymlinkRes) Reset() {
+	*x = SymlinkRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_grpcfs_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SymlinkRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SymlinkRes) ProtoMessage() {}
+
+func (x *SymlinkRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SymlinkRes.ProtoReflect.Descriptor instead.
+func (*SymlinkRes) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SymlinkRes) GetResult() *FileInfo {
+	if x != nil {
+		return x.Result
 	}
 	return nil
 }
 
-type FileInfoRes struct {
+type LinkRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1304,23 +3045,23 @@ type FileInfoRes struct {
 	Result *FileInfo `protobuf:"bytes,1,opt,name=Result,proto3" json:"Result,omitempty"`
 }
 
-func (x *FileInfoRes) Reset() {
-	*x = FileInfoRes{}
+func (x *LinkRes) Reset() {
+	*x = LinkRes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_grpcfs_proto_msgTypes[18]
+		mi := &file_proto_grpcfs_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FileInfoRes) String() string {
+func (x *LinkRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileInfoRes) ProtoMessage() {}
+func (*LinkRes) ProtoMessage() {}
 
-func (x *FileInfoRes) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpcfs_proto_msgTypes[18]
+func (x *LinkRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1331,43 +3072,43 @@ func (x *FileInfoRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileInfoRes.ProtoReflect.Descriptor instead.
-func (*FileInfoRes) Descriptor() ([]byte, []int) {
-	return file_proto_grpcfs_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use LinkRes.ProtoReflect.Descriptor instead.
+func (*LinkRes) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *FileInfoRes) GetResult() *FileInfo {
+func (x *LinkRes) GetResult() *FileInfo {
 	if x != nil {
 		return x.Result
 	}
 	return nil
 }
 
-type OpenDirRes struct {
+type GetXattrRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Result *OpenedDir `protobuf:"bytes,1,opt,name=Result,proto3" json:"Result,omitempty"`
+	Result []byte `protobuf:"bytes,1,opt,name=Result,proto3" json:"Result,omitempty"`
 }
 
-func (x *OpenDirRes) Reset() {
-	*x = OpenDirRes{}
+func (x *GetXattrRes) Reset() {
+	*x = GetXattrRes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_grpcfs_proto_msgTypes[19]
+		mi := &file_proto_grpcfs_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OpenDirRes) String() string {
+func (x *GetXattrRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenDirRes) ProtoMessage() {}
+func (*GetXattrRes) ProtoMessage() {}
 
-func (x *OpenDirRes) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpcfs_proto_msgTypes[19]
+func (x *GetXattrRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1378,43 +3119,43 @@ func (x *OpenDirRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenDirRes.ProtoReflect.Descriptor instead.
-func (*OpenDirRes) Descriptor() ([]byte, []int) {
-	return file_proto_grpcfs_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetXattrRes.ProtoReflect.Descriptor instead.
+func (*GetXattrRes) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *OpenDirRes) GetResult() *OpenedDir {
+func (x *GetXattrRes) GetResult() []byte {
 	if x != nil {
 		return x.Result
 	}
 	return nil
 }
 
-type OpenFileRes struct {
+type ListXattrRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Result *OpenedFile `protobuf:"bytes,1,opt,name=Result,proto3" json:"Result,omitempty"`
+	Result []string `protobuf:"bytes,1,rep,name=Result,proto3" json:"Result,omitempty"`
 }
 
-func (x *OpenFileRes) Reset() {
-	*x = OpenFileRes{}
+func (x *ListXattrRes) Reset() {
+	*x = ListXattrRes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_grpcfs_proto_msgTypes[20]
+		mi := &file_proto_grpcfs_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OpenFileRes) String() string {
+func (x *ListXattrRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenFileRes) ProtoMessage() {}
+func (*ListXattrRes) ProtoMessage() {}
 
-func (x *OpenFileRes) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpcfs_proto_msgTypes[20]
+func (x *ListXattrRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1425,43 +3166,43 @@ func (x *OpenFileRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenFileRes.ProtoReflect.Descriptor instead.
-func (*OpenFileRes) Descriptor() ([]byte, []int) {
-	return file_proto_grpcfs_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ListXattrRes.ProtoReflect.Descriptor instead.
+func (*ListXattrRes) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *OpenFileRes) GetResult() *OpenedFile {
+func (x *ListXattrRes) GetResult() []string {
 	if x != nil {
 		return x.Result
 	}
 	return nil
 }
 
-type ReadDirRes struct {
+type SetXattrRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Result []*DirEntry `protobuf:"bytes,1,rep,name=Result,proto3" json:"Result,omitempty"`
+	Result bool `protobuf:"varint,1,opt,name=Result,proto3" json:"Result,omitempty"`
 }
 
-func (x *ReadDirRes) Reset() {
-	*x = ReadDirRes{}
+func (x *SetXattrRes) Reset() {
+	*x = SetXattrRes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_grpcfs_proto_msgTypes[21]
+		mi := &file_proto_grpcfs_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadDirRes) String() string {
+func (x *SetXattrRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadDirRes) ProtoMessage() {}
+func (*SetXattrRes) ProtoMessage() {}
 
-func (x *ReadDirRes) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpcfs_proto_msgTypes[21]
+func (x *SetXattrRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1472,43 +3213,43 @@ func (x *ReadDirRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadDirRes.ProtoReflect.Descriptor instead.
-func (*ReadDirRes) Descriptor() ([]byte, []int) {
-	return file_proto_grpcfs_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use SetXattrRes.ProtoReflect.Descriptor instead.
+func (*SetXattrRes) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *ReadDirRes) GetResult() []*DirEntry {
+func (x *SetXattrRes) GetResult() bool {
 	if x != nil {
 		return x.Result
 	}
-	return nil
+	return false
 }
 
-type ReadFileRes struct {
+type RemoveXattrRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Result *FileEntry `protobuf:"bytes,1,opt,name=Result,proto3" json:"Result,omitempty"`
+	Result bool `protobuf:"varint,1,opt,name=Result,proto3" json:"Result,omitempty"`
 }
 
-func (x *ReadFileRes) Reset() {
-	*x = ReadFileRes{}
+func (x *RemoveXattrRes) Reset() {
+	*x = RemoveXattrRes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_grpcfs_proto_msgTypes[22]
+		mi := &file_proto_grpcfs_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadFileRes) String() string {
+func (x *RemoveXattrRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadFileRes) ProtoMessage() {}
+func (*RemoveXattrRes) ProtoMessage() {}
 
-func (x *ReadFileRes) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpcfs_proto_msgTypes[22]
+func (x *RemoveXattrRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1519,19 +3260,19 @@ func (x *ReadFileRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadFileRes.ProtoReflect.Descriptor instead.
-func (*ReadFileRes) Descriptor() ([]byte, []int) {
-	return file_proto_grpcfs_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use RemoveXattrRes.ProtoReflect.Descriptor instead.
+func (*RemoveXattrRes) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *ReadFileRes) GetResult() *FileEntry {
+func (x *RemoveXattrRes) GetResult() bool {
 	if x != nil {
 		return x.Result
 	}
-	return nil
+	return false
 }
 
-type WriteFileRes struct {
+type ReleaseHandleRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1539,23 +3280,23 @@ type WriteFileRes struct {
 	Result bool `protobuf:"varint,1,opt,name=Result,proto3" json:"Result,omitempty"`
 }
 
-func (x *WriteFileRes) Reset() {
-	*x = WriteFileRes{}
+func (x *ReleaseHandleRes) Reset() {
+	*x = ReleaseHandleRes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_grpcfs_proto_msgTypes[23]
+		mi := &file_proto_grpcfs_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WriteFileRes) String() string {
+func (x *ReleaseHandleRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteFileRes) ProtoMessage() {}
+func (*ReleaseHandleRes) ProtoMessage() {}
 
-func (x *WriteFileRes) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpcfs_proto_msgTypes[23]
+func (x *ReleaseHandleRes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_grpcfs_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1566,12 +3307,12 @@ func (x *WriteFileRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteFileRes.ProtoReflect.Descriptor instead.
-func (*WriteFileRes) Descriptor() ([]byte, []int) {
-	return file_proto_grpcfs_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ReleaseHandleRes.ProtoReflect.Descriptor instead.
+func (*ReleaseHandleRes) Descriptor() ([]byte, []int) {
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *WriteFileRes) GetResult() bool {
+func (x *ReleaseHandleRes) GetResult() bool {
 	if x != nil {
 		return x.Result
 	}
@@ -1589,7 +3330,7 @@ type SetInodeAttRes struct {
 func (x *SetInodeAttRes) Reset() {
 	*x = SetInodeAttRes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_grpcfs_proto_msgTypes[24]
+		mi := &file_proto_grpcfs_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1602,7 +3343,7 @@ func (x *SetInodeAttRes) String() string {
 func (*SetInodeAttRes) ProtoMessage() {}
 
 func (x *SetInodeAttRes) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_grpcfs_proto_msgTypes[24]
+	mi := &file_proto_grpcfs_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1615,7 +3356,7 @@ func (x *SetInodeAttRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetInodeAttRes.ProtoReflect.Descriptor instead.
 func (*SetInodeAttRes) Descriptor() ([]byte, []int) {
-	return file_proto_grpcfs_proto_rawDescGZIP(), []int{24}
+	return file_proto_grpcfs_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *SetInodeAttRes) GetResult() *InodeAtt {
@@ -1631,42 +3372,46 @@ var file_proto_grpcfs_proto_rawDesc = []byte{
 	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x66, 0x73, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x66, 0x0a, 0x0a, 0x52, 0x50, 0x43,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x7e, 0x0a, 0x0a, 0x52, 0x50, 0x43,
 	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x47, 0x61, 0x74, 0x65, 0x77,
 	0x61, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x47, 0x61, 0x74, 0x65,
 	0x77, 0x61, 0x79, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
 	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x63, 0x63, 0x65,
 	0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x67, 0x65, 0x6e, 0x74,
 	0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x49,
-	0x64, 0x22, 0x47, 0x0a, 0x09, 0x4f, 0x70, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x46, 0x75, 0x73, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
-	0x46, 0x75, 0x73, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x03, 0x50, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x55, 0x69, 0x64, 0x22, 0xd8, 0x01, 0x0a, 0x06, 0x53,
-	0x74, 0x61, 0x74, 0x46, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69,
-	0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
-	0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x46, 0x72, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0a, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x46, 0x72, 0x65, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x41, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x6f, 0x53, 0x69, 0x7a, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x49, 0x6f, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x49, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x49,
-	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x46,
-	0x72, 0x65, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x49, 0x6e, 0x6f, 0x64, 0x65,
-	0x73, 0x46, 0x72, 0x65, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x6f,
-	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x34,
-	0x0a, 0x07, 0x4d, 0x6f, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x4d, 0x6f, 0x64,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x73, 0x44, 0x69, 0x72, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x05, 0x49, 0x73, 0x44, 0x69, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x6e,
-	0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x49, 0x6e, 0x6f, 0x22, 0xa0, 0x01, 0x0a,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x47, 0x0a, 0x09, 0x4f, 0x70, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x75, 0x73, 0x65, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x46, 0x75, 0x73, 0x65, 0x49, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x50, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x50, 0x69, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x55, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x55,
+	0x69, 0x64, 0x22, 0xd8, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x46, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x46, 0x72, 0x65,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x46,
+	0x72, 0x65, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x49, 0x6f, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x49,
+	0x6f, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x49, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x49, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x46, 0x72, 0x65, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x49, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x46, 0x72, 0x65, 0x65, 0x22, 0xcc, 0x01,
+	0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x4d, 0x6f, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x07, 0x4d, 0x6f, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x49, 0x73, 0x44, 0x69, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x49, 0x73, 0x44,
+	0x69, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x6e, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x03, 0x49, 0x6e, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x4e, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x4e, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x44, 0x65,
+	0x76, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x44, 0x65, 0x76, 0x22, 0xa0, 0x01, 0x0a,
 	0x09, 0x4f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x44, 0x69, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x6e,
 	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x49, 0x6e, 0x6f, 0x64, 0x65,
 	0x12, 0x16, 0x0a, 0x06, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
@@ -1725,43 +3470,139 @@ var file_proto_grpcfs_proto_rawDesc = []byte{
 	0x69, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x43, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05,
-	0x43, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x49, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x46, 0x73, 0x52,
+	0x43, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x3c, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x61, 0x64, 0x4f,
+	0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x52, 0x65, 0x61, 0x64, 0x4f,
+	0x6e, 0x6c, 0x79, 0x22, 0x3a, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x28, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22,
+	0x49, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x46, 0x73, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x28, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x63, 0x0a, 0x0b, 0x46, 0x69,
+	0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a,
+	0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x22,
+	0x4a, 0x0a, 0x0a, 0x4f, 0x70, 0x65, 0x6e, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x28, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x61, 0x0a, 0x0b, 0x4f,
+	0x70, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28,
+	0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52,
+	0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x6c, 0x61, 0x67,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x62,
+	0x0a, 0x0a, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x28, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x48, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x22, 0xad, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x48, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69,
+	0x7a, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x0c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50,
+	0x43, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x48,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x22, 0x61, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62,
+	0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x5c, 0x0a, 0x08, 0x4d, 0x6b, 0x44, 0x69,
+	0x72, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52,
+	0x50, 0x43, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x48, 0x0a, 0x08, 0x52, 0x6d, 0x44, 0x69, 0x72, 0x52,
 	0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
 	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43,
 	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
-	0x22, 0x4b, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12,
+	0x22, 0x49, 0x0a, 0x09, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x28, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x63, 0x0a, 0x09, 0x52,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x07,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x4e, 0x65, 0x77, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4e, 0x65, 0x77, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x4b, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x12,
 	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
 	0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x4a, 0x0a,
-	0x0a, 0x4f, 0x70, 0x65, 0x6e, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e,
+	0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x62, 0x0a,
+	0x0a, 0x53, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e,
 	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12,
 	0x28, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
-	0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x4b, 0x0a, 0x0b, 0x4f, 0x70, 0x65,
-	0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x07,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x4a, 0x0a, 0x0a, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69,
-	0x72, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52,
-	0x50, 0x43, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x78, 0x74, 0x22, 0x4b, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x22, 0x5f, 0x0a, 0x07, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x28, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x22, 0x5f, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x58, 0x61, 0x74, 0x74, 0x72, 0x52, 0x65,
 	0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22,
-	0x78, 0x0a, 0x0c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12,
-	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0xa0, 0x02, 0x0a, 0x0e, 0x53, 0x65,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x41, 0x74, 0x74, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x41,
+	0x74, 0x74, 0x72, 0x22, 0x4c, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x58, 0x61, 0x74, 0x74, 0x72,
+	0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50,
+	0x43, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x22, 0x8b, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x58, 0x61, 0x74, 0x74, 0x72, 0x52, 0x65,
+	0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x41, 0x74, 0x74, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x41,
+	0x74, 0x74, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x6c, 0x61,
+	0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x22,
+	0x62, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x58, 0x61, 0x74, 0x74, 0x72, 0x52, 0x65,
+	0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x41, 0x74, 0x74, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x41,
+	0x74, 0x74, 0x72, 0x22, 0x54, 0x0a, 0x10, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x48, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12,
+	0x28, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x50, 0x43, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xb8, 0x02, 0x0a, 0x0e, 0x53, 0x65,
 	0x74, 0x49, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x74, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
 	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65,
 	0x12, 0x28, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
@@ -1777,61 +3618,162 @@ var file_proto_grpcfs_proto_rawDesc = []byte{
 	0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
 	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x03, 0x52, 0x05, 0x4d, 0x54, 0x69, 0x6d, 0x65, 0x88,
-	0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
-	0x46, 0x69, 0x6c, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x41, 0x54, 0x69,
-	0x6d, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x4d, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2f, 0x0a, 0x09,
-	0x53, 0x74, 0x61, 0x74, 0x46, 0x73, 0x52, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x06, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x46, 0x73, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x33, 0x0a,
-	0x0b, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x06,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
-	0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x22, 0x33, 0x0a, 0x0a, 0x4f, 0x70, 0x65, 0x6e, 0x44, 0x69, 0x72, 0x52, 0x65, 0x73,
-	0x12, 0x25, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x44, 0x69, 0x72, 0x52,
-	0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x35, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x6e, 0x46,
-	0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x32,
-	0x0a, 0x0a, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x06,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
-	0x62, 0x2e, 0x44, 0x69, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x22, 0x34, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
-	0x73, 0x12, 0x25, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x26, 0x0a, 0x0c, 0x57, 0x72, 0x69, 0x74,
-	0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x22, 0x36, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x74, 0x74, 0x52,
+	0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x53,
+	0x69, 0x7a, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x6f, 0x64, 0x65,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x41, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x4d,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0x38, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x2f,
+	0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x46, 0x73, 0x52, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x06, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x46, 0x73, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x33, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x12, 0x24,
+	0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x22, 0x33, 0x0a, 0x0a, 0x4f, 0x70, 0x65, 0x6e, 0x44, 0x69, 0x72, 0x52,
+	0x65, 0x73, 0x12, 0x25, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x44, 0x69,
+	0x72, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x35, 0x0a, 0x0b, 0x4f, 0x70, 0x65,
+	0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x22, 0x32, 0x0a, 0x0a, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x12, 0x24,
+	0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x22, 0x34, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x4a, 0x0a, 0x0c, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x57,
+	0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x22, 0x4a, 0x0a, 0x0c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x41, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x22,
+	0x0a, 0x0c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74,
+	0x65, 0x6e, 0x22, 0x4d, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x48, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x22, 0x30, 0x0a, 0x08, 0x4d, 0x6b, 0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x12, 0x24, 0x0a,
+	0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0x22, 0x0a, 0x08, 0x52, 0x6d, 0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x39, 0x0a, 0x09, 0x55, 0x6e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x52, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x4e, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x4e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x22, 0x39, 0x0a, 0x09, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x4e, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0x25, 0x0a,
+	0x0b, 0x52, 0x65, 0x61, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x22, 0x32, 0x0a, 0x0a, 0x53, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x52,
 	0x65, 0x73, 0x12, 0x24, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x74, 0x74,
-	0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x8d, 0x03, 0x0a, 0x0b, 0x46, 0x75, 0x73,
-	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
-	0x46, 0x73, 0x12, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x46, 0x73, 0x52, 0x65,
-	0x71, 0x1a, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x46, 0x73, 0x52, 0x65, 0x73,
-	0x22, 0x00, 0x12, 0x2e, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0f,
-	0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a,
-	0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
-	0x22, 0x00, 0x12, 0x2b, 0x0a, 0x07, 0x4f, 0x70, 0x65, 0x6e, 0x44, 0x69, 0x72, 0x12, 0x0e, 0x2e,
-	0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e,
-	0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12,
-	0x2e, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0f, 0x2e, 0x70, 0x62,
-	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x70,
-	0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12,
-	0x2b, 0x0a, 0x07, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x12, 0x0e, 0x2e, 0x70, 0x62, 0x2e,
-	0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e,
-	0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x08,
-	0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65,
-	0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x09,
-	0x57, 0x72, 0x69, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x57,
-	0x72, 0x69, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x62,
-	0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12,
-	0x37, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x74, 0x74, 0x12, 0x12,
-	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x74, 0x74, 0x52,
-	0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x6f, 0x64, 0x65,
-	0x41, 0x74, 0x74, 0x52, 0x65, 0x73, 0x22, 0x00, 0x42, 0x0b, 0x5a, 0x09, 0x67, 0x72, 0x70, 0x63,
-	0x66, 0x73, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x2f, 0x0a, 0x07, 0x4c, 0x69, 0x6e, 0x6b,
+	0x52, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x25, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x58, 0x61, 0x74, 0x74, 0x72, 0x52, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x22, 0x26, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x58, 0x61, 0x74, 0x74, 0x72, 0x52, 0x65, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x25, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x58,
+	0x61, 0x74, 0x74, 0x72, 0x52, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x28, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x58, 0x61, 0x74, 0x74, 0x72, 0x52, 0x65,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x2a, 0x0a, 0x10, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x36, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x6f, 0x64,
+	0x65, 0x41, 0x74, 0x74, 0x52, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x6f,
+	0x64, 0x65, 0x41, 0x74, 0x74, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x9f, 0x09,
+	0x0a, 0x0b, 0x46, 0x75, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x37, 0x0a,
+	0x0b, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x12, 0x2e, 0x70,
+	0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x46, 0x73,
+	0x12, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x46, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x46, 0x73, 0x52, 0x65, 0x73, 0x22, 0x00,
+	0x12, 0x2e, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0f, 0x2e, 0x70,
+	0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e,
+	0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x22, 0x00,
+	0x12, 0x2b, 0x0a, 0x07, 0x4f, 0x70, 0x65, 0x6e, 0x44, 0x69, 0x72, 0x12, 0x0e, 0x2e, 0x70, 0x62,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x70, 0x62,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x2e, 0x0a,
+	0x08, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x70, 0x62, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x2b, 0x0a,
+	0x07, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x12, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x61, 0x64, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x61, 0x64, 0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65,
+	0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61,
+	0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0e, 0x52, 0x65,
+	0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x0f, 0x2e, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x31, 0x0a, 0x09, 0x57, 0x72, 0x69, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12,
+	0x10, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x1a, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0f, 0x57, 0x72, 0x69, 0x74, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x62, 0x2e,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x28, 0x01,
+	0x30, 0x01, 0x12, 0x34, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65,
+	0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x25, 0x0a, 0x05, 0x4d, 0x6b, 0x44, 0x69,
+	0x72, 0x12, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x6b, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x1a,
+	0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x6b, 0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12,
+	0x25, 0x0a, 0x05, 0x52, 0x6d, 0x44, 0x69, 0x72, 0x12, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x6d,
+	0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x6d, 0x44, 0x69,
+	0x72, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x06, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x12, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x1a,
+	0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x22, 0x00,
+	0x12, 0x28, 0x0a, 0x06, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0d, 0x2e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65,
+	0x61, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64,
+	0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61,
+	0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x07, 0x53, 0x79,
+	0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x79, 0x6d, 0x6c, 0x69,
+	0x6e, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x79, 0x6d, 0x6c, 0x69,
+	0x6e, 0x6b, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x22, 0x0a, 0x04, 0x4c, 0x69, 0x6e, 0x6b, 0x12,
+	0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x0b, 0x2e, 0x70,
+	0x62, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x08, 0x47,
+	0x65, 0x74, 0x58, 0x61, 0x74, 0x74, 0x72, 0x12, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x58, 0x61, 0x74, 0x74, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x58, 0x61, 0x74, 0x74, 0x72, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x09, 0x4c,
+	0x69, 0x73, 0x74, 0x58, 0x61, 0x74, 0x74, 0x72, 0x12, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x58, 0x61, 0x74, 0x74, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x62, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x58, 0x61, 0x74, 0x74, 0x72, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x2e,
+	0x0a, 0x08, 0x53, 0x65, 0x74, 0x58, 0x61, 0x74, 0x74, 0x72, 0x12, 0x0f, 0x2e, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x74, 0x58, 0x61, 0x74, 0x74, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x74, 0x58, 0x61, 0x74, 0x74, 0x72, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x37,
+	0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x58, 0x61, 0x74, 0x74, 0x72, 0x12, 0x12, 0x2e,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x58, 0x61, 0x74, 0x74, 0x72, 0x52, 0x65,
+	0x71, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x58, 0x61, 0x74,
+	0x74, 0x72, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0d, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x14,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x6f,
+	0x64, 0x65, 0x41, 0x74, 0x74, 0x12, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x49, 0x6e,
+	0x6f, 0x64, 0x65, 0x41, 0x74, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x53,
+	0x65, 0x74, 0x49, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x74, 0x74, 0x52, 0x65, 0x73, 0x22, 0x00, 0x42,
+	0x0b, 0x5a, 0x09, 0x67, 0x72, 0x70, 0x63, 0x66, 0x73, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1846,7 +3788,7 @@ func file_proto_grpcfs_proto_rawDescGZIP() []byte {
 	return file_proto_grpcfs_proto_rawDescData
 }
 
-var file_proto_grpcfs_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_proto_grpcfs_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
 var file_proto_grpcfs_proto_goTypes = []any{
 	(*RPCContext)(nil),            // 0: pb.RPCContext
 	(*OpContext)(nil),             // 1: pb.OpContext
@@ -1857,71 +3799,152 @@ var file_proto_grpcfs_proto_goTypes = []any{
 	(*DirEntry)(nil),              // 6: pb.DirEntry
 	(*FileEntry)(nil),             // 7: pb.FileEntry
 	(*InodeAtt)(nil),              // 8: pb.InodeAtt
-	(*StatFsReq)(nil),             // 9: pb.StatFsReq
-	(*FileInfoReq)(nil),           // 10: pb.FileInfoReq
-	(*OpenDirReq)(nil),            // 11: pb.OpenDirReq
-	(*OpenFileReq)(nil),           // 12: pb.OpenFileReq
-	(*ReadDirReq)(nil),            // 13: pb.ReadDirReq
-	(*ReadFileReq)(nil),           // 14: pb.ReadFileReq
-	(*WriteFileReq)(nil),          // 15: pb.WriteFileReq
-	(*SetInodeAttReq)(nil),        // 16: pb.SetInodeAttReq
-	(*StatFsRes)(nil),             // 17: pb.StatFsRes
-	(*FileInfoRes)(nil),           // 18: pb.FileInfoRes
-	(*OpenDirRes)(nil),            // 19: pb.OpenDirRes
-	(*OpenFileRes)(nil),           // 20: pb.OpenFileRes
-	(*ReadDirRes)(nil),            // 21: pb.ReadDirRes
-	(*ReadFileRes)(nil),           // 22: pb.ReadFileRes
-	(*WriteFileRes)(nil),          // 23: pb.WriteFileRes
-	(*SetInodeAttRes)(nil),        // 24: pb.SetInodeAttRes
-	(*timestamppb.Timestamp)(nil), // 25: google.protobuf.Timestamp
+	(*ExportInfo)(nil),            // 9: pb.ExportInfo
+	(*ListExportsReq)(nil),        // 10: pb.ListExportsReq
+	(*StatFsReq)(nil),             // 11: pb.StatFsReq
+	(*FileInfoReq)(nil),           // 12: pb.FileInfoReq
+	(*OpenDirReq)(nil),            // 13: pb.OpenDirReq
+	(*OpenFileReq)(nil),           // 14: pb.OpenFileReq
+	(*ReadDirReq)(nil),            // 15: pb.ReadDirReq
+	(*ReadFileReq)(nil),           // 16: pb.ReadFileReq
+	(*WriteFileReq)(nil),          // 17: pb.WriteFileReq
+	(*CreateFileReq)(nil),         // 18: pb.CreateFileReq
+	(*MkDirReq)(nil),              // 19: pb.MkDirReq
+	(*RmDirReq)(nil),              // 20: pb.RmDirReq
+	(*UnlinkReq)(nil),             // 21: pb.UnlinkReq
+	(*RenameReq)(nil),             // 22: pb.RenameReq
+	(*ReadLinkReq)(nil),           // 23: pb.ReadLinkReq
+	(*SymlinkReq)(nil),            // 24: pb.SymlinkReq
+	(*LinkReq)(nil),               // 25: pb.LinkReq
+	(*GetXattrReq)(nil),           // 26: pb.GetXattrReq
+	(*ListXattrReq)(nil),          // 27: pb.ListXattrReq
+	(*SetXattrReq)(nil),           // 28: pb.SetXattrReq
+	(*RemoveXattrReq)(nil),        // 29: pb.RemoveXattrReq
+	(*ReleaseHandleReq)(nil),      // 30: pb.ReleaseHandleReq
+	(*SetInodeAttReq)(nil),        // 31: pb.SetInodeAttReq
+	(*ListExportsRes)(nil),        // 32: pb.ListExportsRes
+	(*StatFsRes)(nil),             // 33: pb.StatFsRes
+	(*FileInfoRes)(nil),           // 34: pb.FileInfoRes
+	(*OpenDirRes)(nil),            // 35: pb.OpenDirRes
+	(*OpenFileRes)(nil),           // 36: pb.OpenFileRes
+	(*ReadDirRes)(nil),            // 37: pb.ReadDirRes
+	(*ReadFileRes)(nil),           // 38: pb.ReadFileRes
+	(*WriteFileRes)(nil),          // 39: pb.WriteFileRes
+	(*WriteFileAck)(nil),          // 40: pb.WriteFileAck
+	(*CreateFileRes)(nil),         // 41: pb.CreateFileRes
+	(*MkDirRes)(nil),              // 42: pb.MkDirRes
+	(*RmDirRes)(nil),              // 43: pb.RmDirRes
+	(*UnlinkRes)(nil),             // 44: pb.UnlinkRes
+	(*RenameRes)(nil),             // 45: pb.RenameRes
+	(*ReadLinkRes)(nil),           // 46: pb.ReadLinkRes
+	(*SymlinkRes)(nil),            // 47: pb.SymlinkRes
+	(*LinkRes)(nil),               // 48: pb.LinkRes
+	(*GetXattrRes)(nil),           // 49: pb.GetXattrRes
+	(*ListXattrRes)(nil),          // 50: pb.ListXattrRes
+	(*SetXattrRes)(nil),           // 51: pb.SetXattrRes
+	(*RemoveXattrRes)(nil),        // 52: pb.RemoveXattrRes
+	(*ReleaseHandleRes)(nil),      // 53: pb.ReleaseHandleRes
+	(*SetInodeAttRes)(nil),        // 54: pb.SetInodeAttRes
+	(*timestamppb.Timestamp)(nil), // 55: google.protobuf.Timestamp
 }
 var file_proto_grpcfs_proto_depIdxs = []int32{
-	25, // 0: pb.FileInfo.ModTime:type_name -> google.protobuf.Timestamp
+	55, // 0: pb.FileInfo.ModTime:type_name -> google.protobuf.Timestamp
 	1,  // 1: pb.OpenedDir.OpContext:type_name -> pb.OpContext
 	1,  // 2: pb.OpenedFile.OpContext:type_name -> pb.OpContext
 	3,  // 3: pb.DirEntry.Info:type_name -> pb.FileInfo
 	1,  // 4: pb.FileEntry.OpContext:type_name -> pb.OpContext
-	25, // 5: pb.InodeAtt.Atime:type_name -> google.protobuf.Timestamp
-	25, // 6: pb.InodeAtt.Mtime:type_name -> google.protobuf.Timestamp
-	25, // 7: pb.InodeAtt.Ctime:type_name -> google.protobuf.Timestamp
-	0,  // 8: pb.StatFsReq.Context:type_name -> pb.RPCContext
-	0,  // 9: pb.FileInfoReq.Context:type_name -> pb.RPCContext
-	0,  // 10: pb.OpenDirReq.Context:type_name -> pb.RPCContext
-	0,  // 11: pb.OpenFileReq.Context:type_name -> pb.RPCContext
-	0,  // 12: pb.ReadDirReq.Context:type_name -> pb.RPCContext
-	0,  // 13: pb.ReadFileReq.Context:type_name -> pb.RPCContext
-	0,  // 14: pb.WriteFileReq.Context:type_name -> pb.RPCContext
-	0,  // 15: pb.SetInodeAttReq.Context:type_name -> pb.RPCContext
-	25, // 16: pb.SetInodeAttReq.ATime:type_name -> google.protobuf.Timestamp
-	25, // 17: pb.SetInodeAttReq.MTime:type_name -> google.protobuf.Timestamp
-	2,  // 18: pb.StatFsRes.Result:type_name -> pb.StatFs
-	3,  // 19: pb.FileInfoRes.Result:type_name -> pb.FileInfo
-	4,  // 20: pb.OpenDirRes.Result:type_name -> pb.OpenedDir
-	5,  // 21: pb.OpenFileRes.Result:type_name -> pb.OpenedFile
-	6,  // 22: pb.ReadDirRes.Result:type_name -> pb.DirEntry
-	7,  // 23: pb.ReadFileRes.Result:type_name -> pb.FileEntry
-	8,  // 24: pb.SetInodeAttRes.Result:type_name -> pb.InodeAtt
-	9,  // 25: pb.FuseService.StatFs:input_type -> pb.StatFsReq
-	10, // 26: pb.FuseService.FileInfo:input_type -> pb.FileInfoReq
-	11, // 27: pb.FuseService.OpenDir:input_type -> pb.OpenDirReq
-	12, // 28: pb.FuseService.OpenFile:input_type -> pb.OpenFileReq
-	13, // 29: pb.FuseService.ReadDir:input_type -> pb.ReadDirReq
-	14, // 30: pb.FuseService.ReadFile:input_type -> pb.ReadFileReq
-	15, // 31: pb.FuseService.WriteFile:input_type -> pb.WriteFileReq
-	16, // 32: pb.FuseService.SetInodeAtt:input_type -> pb.SetInodeAttReq
-	17, // 33: pb.FuseService.StatFs:output_type -> pb.StatFsRes
-	18, // 34: pb.FuseService.FileInfo:output_type -> pb.FileInfoRes
-	19, // 35: pb.FuseService.OpenDir:output_type -> pb.OpenDirRes
-	20, // 36: pb.FuseService.OpenFile:output_type -> pb.OpenFileRes
-	21, // 37: pb.FuseService.ReadDir:output_type -> pb.ReadDirRes
-	22, // 38: pb.FuseService.ReadFile:output_type -> pb.ReadFileRes
-	23, // 39: pb.FuseService.WriteFile:output_type -> pb.WriteFileRes
-	24, // 40: pb.FuseService.SetInodeAtt:output_type -> pb.SetInodeAttRes
-	33, // [33:41] is the sub-list for method output_type
-	25, // [25:33] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	55, // 5: pb.InodeAtt.Atime:type_name -> google.protobuf.Timestamp
+	55, // 6: pb.InodeAtt.Mtime:type_name -> google.protobuf.Timestamp
+	55, // 7: pb.InodeAtt.Ctime:type_name -> google.protobuf.Timestamp
+	0,  // 8: pb.ListExportsReq.Context:type_name -> pb.RPCContext
+	0,  // 9: pb.StatFsReq.Context:type_name -> pb.RPCContext
+	0,  // 10: pb.FileInfoReq.Context:type_name -> pb.RPCContext
+	0,  // 11: pb.OpenDirReq.Context:type_name -> pb.RPCContext
+	0,  // 12: pb.OpenFileReq.Context:type_name -> pb.RPCContext
+	0,  // 13: pb.ReadDirReq.Context:type_name -> pb.RPCContext
+	0,  // 14: pb.ReadFileReq.Context:type_name -> pb.RPCContext
+	0,  // 15: pb.WriteFileReq.Context:type_name -> pb.RPCContext
+	0,  // 16: pb.CreateFileReq.Context:type_name -> pb.RPCContext
+	0,  // 17: pb.MkDirReq.Context:type_name -> pb.RPCContext
+	0,  // 18: pb.RmDirReq.Context:type_name -> pb.RPCContext
+	0,  // 19: pb.UnlinkReq.Context:type_name -> pb.RPCContext
+	0,  // 20: pb.RenameReq.Context:type_name -> pb.RPCContext
+	0,  // 21: pb.ReadLinkReq.Context:type_name -> pb.RPCContext
+	0,  // 22: pb.SymlinkReq.Context:type_name -> pb.RPCContext
+	0,  // 23: pb.LinkReq.Context:type_name -> pb.RPCContext
+	0,  // 24: pb.GetXattrReq.Context:type_name -> pb.RPCContext
+	0,  // 25: pb.ListXattrReq.Context:type_name -> pb.RPCContext
+	0,  // 26: pb.SetXattrReq.Context:type_name -> pb.RPCContext
+	0,  // 27: pb.RemoveXattrReq.Context:type_name -> pb.RPCContext
+	0,  // 28: pb.ReleaseHandleReq.Context:type_name -> pb.RPCContext
+	0,  // 29: pb.SetInodeAttReq.Context:type_name -> pb.RPCContext
+	55, // 30: pb.SetInodeAttReq.ATime:type_name -> google.protobuf.Timestamp
+	55, // 31: pb.SetInodeAttReq.MTime:type_name -> google.protobuf.Timestamp
+	9,  // 32: pb.ListExportsRes.Result:type_name -> pb.ExportInfo
+	2,  // 33: pb.StatFsRes.Result:type_name -> pb.StatFs
+	3,  // 34: pb.FileInfoRes.Result:type_name -> pb.FileInfo
+	4,  // 35: pb.OpenDirRes.Result:type_name -> pb.OpenedDir
+	5,  // 36: pb.OpenFileRes.Result:type_name -> pb.OpenedFile
+	6,  // 37: pb.ReadDirRes.Result:type_name -> pb.DirEntry
+	7,  // 38: pb.ReadFileRes.Result:type_name -> pb.FileEntry
+	3,  // 39: pb.CreateFileRes.Result:type_name -> pb.FileInfo
+	3,  // 40: pb.MkDirRes.Result:type_name -> pb.FileInfo
+	3,  // 41: pb.SymlinkRes.Result:type_name -> pb.FileInfo
+	3,  // 42: pb.LinkRes.Result:type_name -> pb.FileInfo
+	8,  // 43: pb.SetInodeAttRes.Result:type_name -> pb.InodeAtt
+	10, // 44: pb.FuseService.ListExports:input_type -> pb.ListExportsReq
+	11, // 45: pb.FuseService.StatFs:input_type -> pb.StatFsReq
+	12, // 46: pb.FuseService.FileInfo:input_type -> pb.FileInfoReq
+	13, // 47: pb.FuseService.OpenDir:input_type -> pb.OpenDirReq
+	14, // 48: pb.FuseService.OpenFile:input_type -> pb.OpenFileReq
+	15, // 49: pb.FuseService.ReadDir:input_type -> pb.ReadDirReq
+	16, // 50: pb.FuseService.ReadFile:input_type -> pb.ReadFileReq
+	16, // 51: pb.FuseService.ReadFileStream:input_type -> pb.ReadFileReq
+	17, // 52: pb.FuseService.WriteFile:input_type -> pb.WriteFileReq
+	17, // 53: pb.FuseService.WriteFileStream:input_type -> pb.WriteFileReq
+	18, // 54: pb.FuseService.CreateFile:input_type -> pb.CreateFileReq
+	19, // 55: pb.FuseService.MkDir:input_type -> pb.MkDirReq
+	20, // 56: pb.FuseService.RmDir:input_type -> pb.RmDirReq
+	21, // 57: pb.FuseService.Unlink:input_type -> pb.UnlinkReq
+	22, // 58: pb.FuseService.Rename:input_type -> pb.RenameReq
+	23, // 59: pb.FuseService.ReadLink:input_type -> pb.ReadLinkReq
+	24, // 60: pb.FuseService.Symlink:input_type -> pb.SymlinkReq
+	25, // 61: pb.FuseService.Link:input_type -> pb.LinkReq
+	26, // 62: pb.FuseService.GetXattr:input_type -> pb.GetXattrReq
+	27, // 63: pb.FuseService.ListXattr:input_type -> pb.ListXattrReq
+	28, // 64: pb.FuseService.SetXattr:input_type -> pb.SetXattrReq
+	29, // 65: pb.FuseService.RemoveXattr:input_type -> pb.RemoveXattrReq
+	30, // 66: pb.FuseService.ReleaseHandle:input_type -> pb.ReleaseHandleReq
+	31, // 67: pb.FuseService.SetInodeAtt:input_type -> pb.SetInodeAttReq
+	32, // 68: pb.FuseService.ListExports:output_type -> pb.ListExportsRes
+	33, // 69: pb.FuseService.StatFs:output_type -> pb.StatFsRes
+	34, // 70: pb.FuseService.FileInfo:output_type -> pb.FileInfoRes
+	35, // 71: pb.FuseService.OpenDir:output_type -> pb.OpenDirRes
+	36, // 72: pb.FuseService.OpenFile:output_type -> pb.OpenFileRes
+	37, // 73: pb.FuseService.ReadDir:output_type -> pb.ReadDirRes
+	38, // 74: pb.FuseService.ReadFile:output_type -> pb.ReadFileRes
+	38, // 75: pb.FuseService.ReadFileStream:output_type -> pb.ReadFileRes
+	39, // 76: pb.FuseService.WriteFile:output_type -> pb.WriteFileRes
+	40, // 77: pb.FuseService.WriteFileStream:output_type -> pb.WriteFileAck
+	41, // 78: pb.FuseService.CreateFile:output_type -> pb.CreateFileRes
+	42, // 79: pb.FuseService.MkDir:output_type -> pb.MkDirRes
+	43, // 80: pb.FuseService.RmDir:output_type -> pb.RmDirRes
+	44, // 81: pb.FuseService.Unlink:output_type -> pb.UnlinkRes
+	45, // 82: pb.FuseService.Rename:output_type -> pb.RenameRes
+	46, // 83: pb.FuseService.ReadLink:output_type -> pb.ReadLinkRes
+	47, // 84: pb.FuseService.Symlink:output_type -> pb.SymlinkRes
+	48, // 85: pb.FuseService.Link:output_type -> pb.LinkRes
+	49, // 86: pb.FuseService.GetXattr:output_type -> pb.GetXattrRes
+	50, // 87: pb.FuseService.ListXattr:output_type -> pb.ListXattrRes
+	51, // 88: pb.FuseService.SetXattr:output_type -> pb.SetXattrRes
+	52, // 89: pb.FuseService.RemoveXattr:output_type -> pb.RemoveXattrRes
+	53, // 90: pb.FuseService.ReleaseHandle:output_type -> pb.ReleaseHandleRes
+	54, // 91: pb.FuseService.SetInodeAtt:output_type -> pb.SetInodeAttRes
+	68, // [68:92] is the sub-list for method output_type
+	44, // [44:68] is the sub-list for method input_type
+	44, // [44:44] is the sub-list for extension type_name
+	44, // [44:44] is the sub-list for extension extendee
+	0,  // [0:44] is the sub-list for field type_name
 }
 
 func init() { file_proto_grpcfs_proto_init() }
@@ -1942,8 +3965,140 @@ func file_proto_grpcfs_proto_init() {
 				return nil
 			}
 		}
-		file_proto_grpcfs_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*OpContext); i {
+		file_proto_grpcfs_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*OpContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_grpcfs_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*StatFs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_grpcfs_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*FileInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_grpcfs_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*OpenedDir); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_grpcfs_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*OpenedFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_grpcfs_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*DirEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_grpcfs_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*FileEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_grpcfs_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*InodeAtt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_grpcfs_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_grpcfs_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*ListExportsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_grpcfs_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*StatFsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_grpcfs_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*FileInfoReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1954,8 +4109,8 @@ func file_proto_grpcfs_proto_init() {
 				return nil
 			}
 		}
-		file_proto_grpcfs_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*StatFs); i {
+		file_proto_grpcfs_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*OpenDirReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1966,8 +4121,8 @@ func file_proto_grpcfs_proto_init() {
 				return nil
 			}
 		}
-		file_proto_grpcfs_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*FileInfo); i {
+		file_proto_grpcfs_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*OpenFileReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1978,8 +4133,8 @@ func file_proto_grpcfs_proto_init() {
 				return nil
 			}
 		}
-		file_proto_grpcfs_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*OpenedDir); i {
+		file_proto_grpcfs_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*ReadDirReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1990,8 +4145,8 @@ func file_proto_grpcfs_proto_init() {
 				return nil
 			}
 		}
-		file_proto_grpcfs_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*OpenedFile); i {
+		file_proto_grpcfs_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*ReadFileReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2002,8 +4157,8 @@ func file_proto_grpcfs_proto_init() {
 				return nil
 			}
 		}
-		file_proto_grpcfs_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*DirEntry); i {
+		file_proto_grpcfs_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*WriteFileReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2014,8 +4169,8 @@ func file_proto_grpcfs_proto_init() {
 				return nil
 			}
 		}
-		file_proto_grpcfs_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*FileEntry); i {
+		file_proto_grpcfs_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateFileReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2026,8 +4181,8 @@ func file_proto_grpcfs_proto_init() {
 				return nil
 			}
 		}
-		file_proto_grpcfs_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*InodeAtt); i {
+		file_proto_grpcfs_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*MkDirReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2038,8 +4193,8 @@ func file_proto_grpcfs_proto_init() {
 				return nil
 			}
 		}
-		file_proto_grpcfs_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*StatFsReq); i {
+		file_proto_grpcfs_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*RmDirReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2050,8 +4205,8 @@ func file_proto_grpcfs_proto_init() {
 				return nil
 			}
 		}
-		file_proto_grpcfs_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*FileInfoReq); i {
+		file_proto_grpcfs_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*UnlinkReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2062,8 +4217,8 @@ func file_proto_grpcfs_proto_init() {
 				return nil
 			}
 		}
-		file_proto_grpcfs_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*OpenDirReq); i {
+		file_proto_grpcfs_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*RenameReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2074,8 +4229,8 @@ func file_proto_grpcfs_proto_init() {
 				return nil
 			}
 		}
-		file_proto_grpcfs_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*OpenFileReq); i {
+		file_proto_grpcfs_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*ReadLinkReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2086,8 +4241,8 @@ func file_proto_grpcfs_proto_init() {
 				return nil
 			}
 		}
-		file_proto_grpcfs_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*ReadDirReq); i {
+		file_proto_grpcfs_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*SymlinkReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2098,8 +4253,8 @@ func file_proto_grpcfs_proto_init() {
 				return nil
 			}
 		}
-		file_proto_grpcfs_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*ReadFileReq); i {
+		file_proto_grpcfs_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*LinkReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2110,8 +4265,8 @@ func file_proto_grpcfs_proto_init() {
 				return nil
 			}
 		}
-		file_proto_grpcfs_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*WriteFileReq); i {
+		file_proto_grpcfs_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*GetXattrReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2122,7 +4277,55 @@ func file_proto_grpcfs_proto_init() {
 				return nil
 			}
 		}
-		file_proto_grpcfs_proto_msgTypes[16].Exporter = func(v any, i int) any {
+		file_proto_grpcfs_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*ListXattrReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_grpcfs_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*SetXattrReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_grpcfs_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*RemoveXattrReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_grpcfs_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*ReleaseHandleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_grpcfs_proto_msgTypes[31].Exporter = func(v any, i int) any {
 			switch v := v.(*SetInodeAttReq); i {
 			case 0:
 				return &v.state
@@ -2134,7 +4337,19 @@ func file_proto_grpcfs_proto_init() {
 				return nil
 			}
 		}
-		file_proto_grpcfs_proto_msgTypes[17].Exporter = func(v any, i int) any {
+		file_proto_grpcfs_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*ListExportsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_grpcfs_proto_msgTypes[33].Exporter = func(v any, i int) any {
 			switch v := v.(*StatFsRes); i {
 			case 0:
 				return &v.state
@@ -2146,7 +4361,7 @@ func file_proto_grpcfs_proto_init() {
 				return nil
 			}
 		}
-		file_proto_grpcfs_proto_msgTypes[18].Exporter = func(v any, i int) any {
+		file_proto_grpcfs_proto_msgTypes[34].Exporter = func(v any, i int) any {
 			switch v := v.(*FileInfoRes); i {
 			case 0:
 				return &v.state
@@ -2158,7 +4373,7 @@ func file_proto_grpcfs_proto_init() {
 				return nil
 			}
 		}
-		file_proto_grpcfs_proto_msgTypes[19].Exporter = func(v any, i int) any {
+		file_proto_grpcfs_proto_msgTypes[35].Exporter = func(v any, i int) any {
 			switch v := v.(*OpenDirRes); i {
 			case 0:
 				return &v.state
@@ -2170,7 +4385,7 @@ func file_proto_grpcfs_proto_init() {
 				return nil
 			}
 		}
-		file_proto_grpcfs_proto_msgTypes[20].Exporter = func(v any, i int) any {
+		file_proto_grpcfs_proto_msgTypes[36].Exporter = func(v any, i int) any {
 			switch v := v.(*OpenFileRes); i {
 			case 0:
 				return &v.state
@@ -2182,7 +4397,7 @@ func file_proto_grpcfs_proto_init() {
 				return nil
 			}
 		}
-		file_proto_grpcfs_proto_msgTypes[21].Exporter = func(v any, i int) any {
+		file_proto_grpcfs_proto_msgTypes[37].Exporter = func(v any, i int) any {
 			switch v := v.(*ReadDirRes); i {
 			case 0:
 				return &v.state
@@ -2194,7 +4409,7 @@ func file_proto_grpcfs_proto_init() {
 				return nil
 			}
 		}
-		file_proto_grpcfs_proto_msgTypes[22].Exporter = func(v any, i int) any {
+		file_proto_grpcfs_proto_msgTypes[38].Exporter = func(v any, i int) any {
 			switch v := v.(*ReadFileRes); i {
 			case 0:
 				return &v.state
@@ -2206,7 +4421,7 @@ func file_proto_grpcfs_proto_init() {
 				return nil
 			}
 		}
-		file_proto_grpcfs_proto_msgTypes[23].Exporter = func(v any, i int) any {
+		file_proto_grpcfs_proto_msgTypes[39].Exporter = func(v any, i int) any {
 			switch v := v.(*WriteFileRes); i {
 			case 0:
 				return &v.state
@@ -2218,7 +4433,175 @@ func file_proto_grpcfs_proto_init() {
 				return nil
 			}
 		}
-		file_proto_grpcfs_proto_msgTypes[24].Exporter = func(v any, i int) any {
+		file_proto_grpcfs_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*WriteFileAck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_grpcfs_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateFileRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_grpcfs_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*MkDirRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_grpcfs_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*RmDirRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_grpcfs_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*UnlinkRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_grpcfs_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*RenameRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_grpcfs_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*ReadLinkRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_grpcfs_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*SymlinkRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_grpcfs_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*LinkRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_grpcfs_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*GetXattrRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_grpcfs_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*ListXattrRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_grpcfs_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*SetXattrRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_grpcfs_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*RemoveXattrRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_grpcfs_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*ReleaseHandleRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_grpcfs_proto_msgTypes[54].Exporter = func(v any, i int) any {
 			switch v := v.(*SetInodeAttRes); i {
 			case 0:
 				return &v.state
@@ -2231,14 +4614,14 @@ func file_proto_grpcfs_proto_init() {
 			}
 		}
 	}
-	file_proto_grpcfs_proto_msgTypes[16].OneofWrappers = []any{}
+	file_proto_grpcfs_proto_msgTypes[31].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_grpcfs_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   25,
+			NumMessages:   55,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,14 +19,30 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	FuseService_StatFs_FullMethodName      = "/pb.FuseService/StatFs"
-	FuseService_FileInfo_FullMethodName    = "/pb.FuseService/FileInfo"
-	FuseService_OpenDir_FullMethodName     = "/pb.FuseService/OpenDir"
-	FuseService_OpenFile_FullMethodName    = "/pb.FuseService/OpenFile"
-	FuseService_ReadDir_FullMethodName     = "/pb.FuseService/ReadDir"
-	FuseService_ReadFile_FullMethodName    = "/pb.FuseService/ReadFile"
-	FuseService_WriteFile_FullMethodName   = "/pb.FuseService/WriteFile"
-	FuseService_SetInodeAtt_FullMethodName = "/pb.FuseService/SetInodeAtt"
+	FuseService_ListExports_FullMethodName     = "/pb.FuseService/ListExports"
+	FuseService_StatFs_FullMethodName          = "/pb.FuseService/StatFs"
+	FuseService_FileInfo_FullMethodName        = "/pb.FuseService/FileInfo"
+	FuseService_OpenDir_FullMethodName         = "/pb.FuseService/OpenDir"
+	FuseService_OpenFile_FullMethodName        = "/pb.FuseService/OpenFile"
+	FuseService_ReadDir_FullMethodName         = "/pb.FuseService/ReadDir"
+	FuseService_ReadFile_FullMethodName        = "/pb.FuseService/ReadFile"
+	FuseService_ReadFileStream_FullMethodName  = "/pb.FuseService/ReadFileStream"
+	FuseService_WriteFile_FullMethodName       = "/pb.FuseService/WriteFile"
+	FuseService_WriteFileStream_FullMethodName = "/pb.FuseService/WriteFileStream"
+	FuseService_CreateFile_FullMethodName      = "/pb.FuseService/CreateFile"
+	FuseService_MkDir_FullMethodName           = "/pb.FuseService/MkDir"
+	FuseService_RmDir_FullMethodName           = "/pb.FuseService/RmDir"
+	FuseService_Unlink_FullMethodName          = "/pb.FuseService/Unlink"
+	FuseService_Rename_FullMethodName          = "/pb.FuseService/Rename"
+	FuseService_ReadLink_FullMethodName        = "/pb.FuseService/ReadLink"
+	FuseService_Symlink_FullMethodName         = "/pb.FuseService/Symlink"
+	FuseService_Link_FullMethodName            = "/pb.FuseService/Link"
+	FuseService_GetXattr_FullMethodName        = "/pb.FuseService/GetXattr"
+	FuseService_ListXattr_FullMethodName       = "/pb.FuseService/ListXattr"
+	FuseService_SetXattr_FullMethodName        = "/pb.FuseService/SetXattr"
+	FuseService_RemoveXattr_FullMethodName     = "/pb.FuseService/RemoveXattr"
+	FuseService_ReleaseHandle_FullMethodName   = "/pb.FuseService/ReleaseHandle"
+	FuseService_SetInodeAtt_FullMethodName     = "/pb.FuseService/SetInodeAtt"
 )
 
 // FuseServiceClient is the client API for FuseService service.
@@ -35,13 +51,29 @@ const (
 //
 // Service Definition
 type FuseServiceClient interface {
+	ListExports(ctx context.Context, in *ListExportsReq, opts ...grpc.CallOption) (*ListExportsRes, error)
 	StatFs(ctx context.Context, in *StatFsReq, opts ...grpc.CallOption) (*StatFsRes, error)
 	FileInfo(ctx context.Context, in *FileInfoReq, opts ...grpc.CallOption) (*FileInfoRes, error)
 	OpenDir(ctx context.Context, in *OpenDirReq, opts ...grpc.CallOption) (*OpenDirRes, error)
 	OpenFile(ctx context.Context, in *OpenFileReq, opts ...grpc.CallOption) (*OpenFileRes, error)
 	ReadDir(ctx context.Context, in *ReadDirReq, opts ...grpc.CallOption) (*ReadDirRes, error)
 	ReadFile(ctx context.Context, in *ReadFileReq, opts ...grpc.CallOption) (*ReadFileRes, error)
+	ReadFileStream(ctx context.Context, in *ReadFileReq, opts ...grpc.CallOption) (FuseService_ReadFileStreamClient, error)
 	WriteFile(ctx context.Context, in *WriteFileReq, opts ...grpc.CallOption) (*WriteFileRes, error)
+	WriteFileStream(ctx context.Context, opts ...grpc.CallOption) (FuseService_WriteFileStreamClient, error)
+	CreateFile(ctx context.Context, in *CreateFileReq, opts ...grpc.CallOption) (*CreateFileRes, error)
+	MkDir(ctx context.Context, in *MkDirReq, opts ...grpc.CallOption) (*MkDirRes, error)
+	RmDir(ctx context.Context, in *RmDirReq, opts ...grpc.CallOption) (*RmDirRes, error)
+	Unlink(ctx context.Context, in *UnlinkReq, opts ...grpc.CallOption) (*UnlinkRes, error)
+	Rename(ctx context.Context, in *RenameReq, opts ...grpc.CallOption) (*RenameRes, error)
+	ReadLink(ctx context.Context, in *ReadLinkReq, opts ...grpc.CallOption) (*ReadLinkRes, error)
+	Symlink(ctx context.Context, in *SymlinkReq, opts ...grpc.CallOption) (*SymlinkRes, error)
+	Link(ctx context.Context, in *LinkReq, opts ...grpc.CallOption) (*LinkRes, error)
+	GetXattr(ctx context.Context, in *GetXattrReq, opts ...grpc.CallOption) (*GetXattrRes, error)
+	ListXattr(ctx context.Context, in *ListXattrReq, opts ...grpc.CallOption) (*ListXattrRes, error)
+	SetXattr(ctx context.Context, in *SetXattrReq, opts ...grpc.CallOption) (*SetXattrRes, error)
+	RemoveXattr(ctx context.Context, in *RemoveXattrReq, opts ...grpc.CallOption) (*RemoveXattrRes, error)
+	ReleaseHandle(ctx context.Context, in *ReleaseHandleReq, opts ...grpc.CallOption) (*ReleaseHandleRes, error)
 	SetInodeAtt(ctx context.Context, in *SetInodeAttReq, opts ...grpc.CallOption) (*SetInodeAttRes, error)
 }
 
@@ -53,6 +85,16 @@ func NewFuseServiceClient(cc grpc.ClientConnInterface) FuseServiceClient {
 	return &fuseServiceClient{cc}
 }
 
+func (c *fuseServiceClient) ListExports(ctx context.Context, in *ListExportsReq, opts ...grpc.CallOption) (*ListExportsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExportsRes)
+	err := c.cc.Invoke(ctx, FuseService_ListExports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fuseServiceClient) StatFs(ctx context.Context, in *StatFsReq, opts ...grpc.CallOption) (*StatFsRes, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StatFsRes)
@@ -113,6 +155,39 @@ func (c *fuseServiceClient) ReadFile(ctx context.Context, in *ReadFileReq, opts
 	return out, nil
 }
 
+func (c *fuseServiceClient) ReadFileStream(ctx context.Context, in *ReadFileReq, opts ...grpc.CallOption) (FuseService_ReadFileStreamClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FuseService_ServiceDesc.Streams[0], FuseService_ReadFileStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &fuseServiceReadFileStreamClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FuseService_ReadFileStreamClient interface {
+	Recv() (*ReadFileRes, error)
+	grpc.ClientStream
+}
+
+type fuseServiceReadFileStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *fuseServiceReadFileStreamClient) Recv() (*ReadFileRes, error) {
+	m := new(ReadFileRes)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *fuseServiceClient) WriteFile(ctx context.Context, in *WriteFileReq, opts ...grpc.CallOption) (*WriteFileRes, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(WriteFileRes)
@@ -123,6 +198,168 @@ func (c *fuseServiceClient) WriteFile(ctx context.Context, in *WriteFileReq, opt
 	return out, nil
 }
 
+func (c *fuseServiceClient) WriteFileStream(ctx context.Context, opts ...grpc.CallOption) (FuseService_WriteFileStreamClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FuseService_ServiceDesc.Streams[1], FuseService_WriteFileStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &fuseServiceWriteFileStreamClient{ClientStream: stream}
+	return x, nil
+}
+
+type FuseService_WriteFileStreamClient interface {
+	Send(*WriteFileReq) error
+	Recv() (*WriteFileAck, error)
+	grpc.ClientStream
+}
+
+type fuseServiceWriteFileStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *fuseServiceWriteFileStreamClient) Send(m *WriteFileReq) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *fuseServiceWriteFileStreamClient) Recv() (*WriteFileAck, error) {
+	m := new(WriteFileAck)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *fuseServiceClient) CreateFile(ctx context.Context, in *CreateFileReq, opts ...grpc.CallOption) (*CreateFileRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateFileRes)
+	err := c.cc.Invoke(ctx, FuseService_CreateFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuseServiceClient) MkDir(ctx context.Context, in *MkDirReq, opts ...grpc.CallOption) (*MkDirRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MkDirRes)
+	err := c.cc.Invoke(ctx, FuseService_MkDir_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuseServiceClient) RmDir(ctx context.Context, in *RmDirReq, opts ...grpc.CallOption) (*RmDirRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RmDirRes)
+	err := c.cc.Invoke(ctx, FuseService_RmDir_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuseServiceClient) Unlink(ctx context.Context, in *UnlinkReq, opts ...grpc.CallOption) (*UnlinkRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlinkRes)
+	err := c.cc.Invoke(ctx, FuseService_Unlink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuseServiceClient) Rename(ctx context.Context, in *RenameReq, opts ...grpc.CallOption) (*RenameRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameRes)
+	err := c.cc.Invoke(ctx, FuseService_Rename_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuseServiceClient) ReadLink(ctx context.Context, in *ReadLinkReq, opts ...grpc.CallOption) (*ReadLinkRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadLinkRes)
+	err := c.cc.Invoke(ctx, FuseService_ReadLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuseServiceClient) Symlink(ctx context.Context, in *SymlinkReq, opts ...grpc.CallOption) (*SymlinkRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SymlinkRes)
+	err := c.cc.Invoke(ctx, FuseService_Symlink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuseServiceClient) Link(ctx context.Context, in *LinkReq, opts ...grpc.CallOption) (*LinkRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkRes)
+	err := c.cc.Invoke(ctx, FuseService_Link_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuseServiceClient) GetXattr(ctx context.Context, in *GetXattrReq, opts ...grpc.CallOption) (*GetXattrRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetXattrRes)
+	err := c.cc.Invoke(ctx, FuseService_GetXattr_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuseServiceClient) ListXattr(ctx context.Context, in *ListXattrReq, opts ...grpc.CallOption) (*ListXattrRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListXattrRes)
+	err := c.cc.Invoke(ctx, FuseService_ListXattr_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuseServiceClient) SetXattr(ctx context.Context, in *SetXattrReq, opts ...grpc.CallOption) (*SetXattrRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetXattrRes)
+	err := c.cc.Invoke(ctx, FuseService_SetXattr_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuseServiceClient) RemoveXattr(ctx context.Context, in *RemoveXattrReq, opts ...grpc.CallOption) (*RemoveXattrRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveXattrRes)
+	err := c.cc.Invoke(ctx, FuseService_RemoveXattr_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuseServiceClient) ReleaseHandle(ctx context.Context, in *ReleaseHandleReq, opts ...grpc.CallOption) (*ReleaseHandleRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseHandleRes)
+	err := c.cc.Invoke(ctx, FuseService_ReleaseHandle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fuseServiceClient) SetInodeAtt(ctx context.Context, in *SetInodeAttReq, opts ...grpc.CallOption) (*SetInodeAttRes, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SetInodeAttRes)
@@ -139,13 +376,29 @@ func (c *fuseServiceClient) SetInodeAtt(ctx context.Context, in *SetInodeAttReq,
 //
 // Service Definition
 type FuseServiceServer interface {
+	ListExports(context.Context, *ListExportsReq) (*ListExportsRes, error)
 	StatFs(context.Context, *StatFsReq) (*StatFsRes, error)
 	FileInfo(context.Context, *FileInfoReq) (*FileInfoRes, error)
 	OpenDir(context.Context, *OpenDirReq) (*OpenDirRes, error)
 	OpenFile(context.Context, *OpenFileReq) (*OpenFileRes, error)
 	ReadDir(context.Context, *ReadDirReq) (*ReadDirRes, error)
 	ReadFile(context.Context, *ReadFileReq) (*ReadFileRes, error)
+	ReadFileStream(*ReadFileReq, FuseService_ReadFileStreamServer) error
 	WriteFile(context.Context, *WriteFileReq) (*WriteFileRes, error)
+	WriteFileStream(FuseService_WriteFileStreamServer) error
+	CreateFile(context.Context, *CreateFileReq) (*CreateFileRes, error)
+	MkDir(context.Context, *MkDirReq) (*MkDirRes, error)
+	RmDir(context.Context, *RmDirReq) (*RmDirRes, error)
+	Unlink(context.Context, *UnlinkReq) (*UnlinkRes, error)
+	Rename(context.Context, *RenameReq) (*RenameRes, error)
+	ReadLink(context.Context, *ReadLinkReq) (*ReadLinkRes, error)
+	Symlink(context.Context, *SymlinkReq) (*SymlinkRes, error)
+	Link(context.Context, *LinkReq) (*LinkRes, error)
+	GetXattr(context.Context, *GetXattrReq) (*GetXattrRes, error)
+	ListXattr(context.Context, *ListXattrReq) (*ListXattrRes, error)
+	SetXattr(context.Context, *SetXattrReq) (*SetXattrRes, error)
+	RemoveXattr(context.Context, *RemoveXattrReq) (*RemoveXattrRes, error)
+	ReleaseHandle(context.Context, *ReleaseHandleReq) (*ReleaseHandleRes, error)
 	SetInodeAtt(context.Context, *SetInodeAttReq) (*SetInodeAttRes, error)
 	mustEmbedUnimplementedFuseServiceServer()
 }
@@ -154,6 +407,9 @@ type FuseServiceServer interface {
 type UnimplementedFuseServiceServer struct {
 }
 
+func (UnimplementedFuseServiceServer) ListExports(context.Context, *ListExportsReq) (*ListExportsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExports not implemented")
+}
 func (UnimplementedFuseServiceServer) StatFs(context.Context, *StatFsReq) (*StatFsRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StatFs not implemented")
 }
@@ -172,9 +428,54 @@ func (UnimplementedFuseServiceServer) ReadDir(context.Context, *ReadDirReq) (*Re
 func (UnimplementedFuseServiceServer) ReadFile(context.Context, *ReadFileReq) (*ReadFileRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadFile not implemented")
 }
+func (UnimplementedFuseServiceServer) ReadFileStream(*ReadFileReq, FuseService_ReadFileStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReadFileStream not implemented")
+}
 func (UnimplementedFuseServiceServer) WriteFile(context.Context, *WriteFileReq) (*WriteFileRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WriteFile not implemented")
 }
+func (UnimplementedFuseServiceServer) WriteFileStream(FuseService_WriteFileStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method WriteFileStream not implemented")
+}
+func (UnimplementedFuseServiceServer) CreateFile(context.Context, *CreateFileReq) (*CreateFileRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFile not implemented")
+}
+func (UnimplementedFuseServiceServer) MkDir(context.Context, *MkDirReq) (*MkDirRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MkDir not implemented")
+}
+func (UnimplementedFuseServiceServer) RmDir(context.Context, *RmDirReq) (*RmDirRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RmDir not implemented")
+}
+func (UnimplementedFuseServiceServer) Unlink(context.Context, *UnlinkReq) (*UnlinkRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unlink not implemented")
+}
+func (UnimplementedFuseServiceServer) Rename(context.Context, *RenameReq) (*RenameRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rename not implemented")
+}
+func (UnimplementedFuseServiceServer) ReadLink(context.Context, *ReadLinkReq) (*ReadLinkRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadLink not implemented")
+}
+func (UnimplementedFuseServiceServer) Symlink(context.Context, *SymlinkReq) (*SymlinkRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Symlink not implemented")
+}
+func (UnimplementedFuseServiceServer) Link(context.Context, *LinkReq) (*LinkRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Link not implemented")
+}
+func (UnimplementedFuseServiceServer) GetXattr(context.Context, *GetXattrReq) (*GetXattrRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetXattr not implemented")
+}
+func (UnimplementedFuseServiceServer) ListXattr(context.Context, *ListXattrReq) (*ListXattrRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListXattr not implemented")
+}
+func (UnimplementedFuseServiceServer) SetXattr(context.Context, *SetXattrReq) (*SetXattrRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetXattr not implemented")
+}
+func (UnimplementedFuseServiceServer) RemoveXattr(context.Context, *RemoveXattrReq) (*RemoveXattrRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveXattr not implemented")
+}
+func (UnimplementedFuseServiceServer) ReleaseHandle(context.Context, *ReleaseHandleReq) (*ReleaseHandleRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseHandle not implemented")
+}
 func (UnimplementedFuseServiceServer) SetInodeAtt(context.Context, *SetInodeAttReq) (*SetInodeAttRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetInodeAtt not implemented")
 }
@@ -191,6 +492,24 @@ func RegisterFuseServiceServer(s grpc.ServiceRegistrar, srv FuseServiceServer) {
 	s.RegisterService(&FuseService_ServiceDesc, srv)
 }
 
+func _FuseService_ListExports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExportsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuseServiceServer).ListExports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuseService_ListExports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuseServiceServer).ListExports(ctx, req.(*ListExportsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FuseService_StatFs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StatFsReq)
 	if err := dec(in); err != nil {
@@ -299,6 +618,27 @@ func _FuseService_ReadFile_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FuseService_ReadFileStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadFileReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FuseServiceServer).ReadFileStream(m, &fuseServiceReadFileStreamServer{ServerStream: stream})
+}
+
+type FuseService_ReadFileStreamServer interface {
+	Send(*ReadFileRes) error
+	grpc.ServerStream
+}
+
+type fuseServiceReadFileStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *fuseServiceReadFileStreamServer) Send(m *ReadFileRes) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _FuseService_WriteFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WriteFileReq)
 	if err := dec(in); err != nil {
@@ -317,6 +657,266 @@ func _FuseService_WriteFile_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FuseService_WriteFileStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(FuseServiceServer).WriteFileStream(&fuseServiceWriteFileStreamServer{ServerStream: stream})
+}
+
+type FuseService_WriteFileStreamServer interface {
+	Send(*WriteFileAck) error
+	Recv() (*WriteFileReq, error)
+	grpc.ServerStream
+}
+
+type fuseServiceWriteFileStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *fuseServiceWriteFileStreamServer) Send(m *WriteFileAck) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *fuseServiceWriteFileStreamServer) Recv() (*WriteFileReq, error) {
+	m := new(WriteFileReq)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _FuseService_CreateFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFileReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuseServiceServer).CreateFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuseService_CreateFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuseServiceServer).CreateFile(ctx, req.(*CreateFileReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuseService_MkDir_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MkDirReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuseServiceServer).MkDir(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuseService_MkDir_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuseServiceServer).MkDir(ctx, req.(*MkDirReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuseService_RmDir_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RmDirReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuseServiceServer).RmDir(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuseService_RmDir_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuseServiceServer).RmDir(ctx, req.(*RmDirReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuseService_Unlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuseServiceServer).Unlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuseService_Unlink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuseServiceServer).Unlink(ctx, req.(*UnlinkReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuseService_Rename_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuseServiceServer).Rename(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuseService_Rename_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuseServiceServer).Rename(ctx, req.(*RenameReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuseService_ReadLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadLinkReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuseServiceServer).ReadLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuseService_ReadLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuseServiceServer).ReadLink(ctx, req.(*ReadLinkReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuseService_Symlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SymlinkReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuseServiceServer).Symlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuseService_Symlink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuseServiceServer).Symlink(ctx, req.(*SymlinkReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuseService_Link_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuseServiceServer).Link(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuseService_Link_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuseServiceServer).Link(ctx, req.(*LinkReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuseService_GetXattr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetXattrReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuseServiceServer).GetXattr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuseService_GetXattr_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuseServiceServer).GetXattr(ctx, req.(*GetXattrReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuseService_ListXattr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListXattrReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuseServiceServer).ListXattr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuseService_ListXattr_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuseServiceServer).ListXattr(ctx, req.(*ListXattrReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuseService_SetXattr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetXattrReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuseServiceServer).SetXattr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuseService_SetXattr_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuseServiceServer).SetXattr(ctx, req.(*SetXattrReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuseService_RemoveXattr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveXattrReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuseServiceServer).RemoveXattr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuseService_RemoveXattr_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuseServiceServer).RemoveXattr(ctx, req.(*RemoveXattrReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuseService_ReleaseHandle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseHandleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuseServiceServer).ReleaseHandle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuseService_ReleaseHandle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuseServiceServer).ReleaseHandle(ctx, req.(*ReleaseHandleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FuseService_SetInodeAtt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetInodeAttReq)
 	if err := dec(in); err != nil {
@@ -342,6 +942,10 @@ var FuseService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "pb.FuseService",
 	HandlerType: (*FuseServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListExports",
+			Handler:    _FuseService_ListExports_Handler,
+		},
 		{
 			MethodName: "StatFs",
 			Handler:    _FuseService_StatFs_Handler,
@@ -370,11 +974,75 @@ var FuseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "WriteFile",
 			Handler:    _FuseService_WriteFile_Handler,
 		},
+		{
+			MethodName: "CreateFile",
+			Handler:    _FuseService_CreateFile_Handler,
+		},
+		{
+			MethodName: "MkDir",
+			Handler:    _FuseService_MkDir_Handler,
+		},
+		{
+			MethodName: "RmDir",
+			Handler:    _FuseService_RmDir_Handler,
+		},
+		{
+			MethodName: "Unlink",
+			Handler:    _FuseService_Unlink_Handler,
+		},
+		{
+			MethodName: "Rename",
+			Handler:    _FuseService_Rename_Handler,
+		},
+		{
+			MethodName: "ReadLink",
+			Handler:    _FuseService_ReadLink_Handler,
+		},
+		{
+			MethodName: "Symlink",
+			Handler:    _FuseService_Symlink_Handler,
+		},
+		{
+			MethodName: "Link",
+			Handler:    _FuseService_Link_Handler,
+		},
+		{
+			MethodName: "GetXattr",
+			Handler:    _FuseService_GetXattr_Handler,
+		},
+		{
+			MethodName: "ListXattr",
+			Handler:    _FuseService_ListXattr_Handler,
+		},
+		{
+			MethodName: "SetXattr",
+			Handler:    _FuseService_SetXattr_Handler,
+		},
+		{
+			MethodName: "RemoveXattr",
+			Handler:    _FuseService_RemoveXattr_Handler,
+		},
+		{
+			MethodName: "ReleaseHandle",
+			Handler:    _FuseService_ReleaseHandle_Handler,
+		},
 		{
 			MethodName: "SetInodeAtt",
 			Handler:    _FuseService_SetInodeAtt_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ReadFileStream",
+			Handler:       _FuseService_ReadFileStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WriteFileStream",
+			Handler:       _FuseService_WriteFileStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "proto/grpcfs.proto",
 }
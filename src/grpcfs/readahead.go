@@ -0,0 +1,84 @@
+// place for read-ahead of open files
+
+package grpcfs
+
+import (
+	"context"
+	"sync"
+
+	"github.com/jacobsa/fuse/fuseops"
+)
+
+// a window of file contents fetched ahead of the sequential reads of a handle
+type readAhead struct {
+	mu    sync.Mutex
+	inode fuseops.InodeID
+	// the offset the window starts at, and whether it reaches the end of the
+	// file
+	offset int64
+	data   []byte
+	eof    bool
+	// where the next read starts if the handle is read sequentially
+	next int64
+}
+
+// serves the range of a file held in the window, if any
+func (ra *readAhead) cached(offset int64, size int64) ([]byte, bool) {
+	end := ra.offset + int64(len(ra.data))
+	if offset < ra.offset || offset >= end || (offset+size > end && !ra.eof) {
+		return nil, false
+	}
+	return ra.data[offset-ra.offset : min(offset+size, end)-ra.offset], true
+}
+
+// reads a range of an open file. Once the handle is read sequentially, a
+// window larger than the range is fetched, so that the reads that follow are
+// served without a round trip to the server.
+func (fs *grpcFs) read(ctx context.Context, inode fuseops.InodeID, handle fuseops.HandleID, offset int64, size int64) ([]byte, error) {
+	if fs.config.ReadAhead < 0 {
+		return fs.fetch(ctx, handle, offset, size)
+	}
+	entry, _ := fs.readAheads.LoadOrStore(handle, &readAhead{inode: inode})
+	ra := entry.(*readAhead)
+	ra.mu.Lock()
+	defer ra.mu.Unlock()
+
+	if data, found := ra.cached(offset, size); found {
+		ra.next = offset + int64(len(data))
+		return data, nil
+	}
+	sequential := offset == ra.next
+	ra.next = offset + size
+	if !sequential {
+		return fs.fetch(ctx, handle, offset, size)
+	}
+	window := max(size, fs.config.ReadAhead)
+	data, err := fs.fetch(ctx, handle, offset, window)
+	if err != nil {
+		return nil, err
+	}
+	ra.offset, ra.data, ra.eof = offset, data, int64(len(data)) < window
+	return data[:min(size, int64(len(data)))], nil
+}
+
+// fetches a range of an open file, streaming it if too large for one message
+func (fs *grpcFs) fetch(ctx context.Context, handle fuseops.HandleID, offset int64, size int64) ([]byte, error) {
+	if size > fs.config.ChunkSize {
+		return readFileStream(fs.client, ctx, uint64(handle), offset, size, fs.config.ChunkSize)
+	}
+	return readFile(fs.client, ctx, uint64(handle), offset, size)
+}
+
+// discards the windows read ahead of an inode's handles once its contents
+// change
+func (fs *grpcFs) dropReadAhead(inode fuseops.InodeID) {
+	fs.readAheads.Range(func(_, entry any) bool {
+		ra := entry.(*readAhead)
+		if ra.inode == inode {
+			ra.mu.Lock()
+			ra.data, ra.eof = nil, false
+			ra.mu.Unlock()
+		}
+		return true
+	})
+}
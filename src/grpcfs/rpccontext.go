@@ -0,0 +1,58 @@
+// place for the context sent along with every request
+
+package grpcfs
+
+import (
+	"context"
+	pb "grpcfs/pb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Dial options filling the RPCContext of every request sent to the server,
+// identifying the gateway and agent behind the mount and carrying the token
+// they are authenticated with
+func WithRPCContext(rpcCtx *pb.RPCContext) []grpc.DialOption {
+	unary := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		setRPCContext(req, rpcCtx)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	stream := func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		clientStream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return &rpcContextStream{ClientStream: clientStream, rpcCtx: rpcCtx}, nil
+	}
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(unary),
+		grpc.WithChainStreamInterceptor(stream),
+	}
+}
+
+// fills the RPCContext of each message sent over a stream
+type rpcContextStream struct {
+	grpc.ClientStream
+	rpcCtx *pb.RPCContext
+}
+
+func (s *rpcContextStream) SendMsg(msg any) error {
+	setRPCContext(msg, s.rpcCtx)
+	return s.ClientStream.SendMsg(msg)
+}
+
+// sets the Context field every request message has
+func setRPCContext(msg any, rpcCtx *pb.RPCContext) {
+	message, ok := msg.(proto.Message)
+	if !ok {
+		return
+	}
+	reflected := message.ProtoReflect()
+	field := reflected.Descriptor().Fields().ByName("Context")
+	if field == nil || field.Message() == nil || field.Message().FullName() != rpcCtx.ProtoReflect().Descriptor().FullName() {
+		return
+	}
+	reflected.Set(field, protoreflect.ValueOfMessage(rpcCtx.ProtoReflect()))
+}
@@ -0,0 +1,149 @@
+// place for streaming of sequential writes
+
+package grpcfs
+
+import (
+	"context"
+	"errors"
+	pb "grpcfs/pb"
+	"io"
+	"log"
+	"sync"
+
+	"github.com/jacobsa/fuse/fuseops"
+)
+
+// the sequential writes to an open file, sent over a single stream without
+// waiting on each other. The server acknowledges the offset it has committed
+// up to, and errors are reported once the stream is flushed.
+type writeStream struct {
+	mu     sync.Mutex
+	inode  fuseops.InodeID
+	stream pb.FuseService_WriteFileStreamClient
+	cancel context.CancelFunc
+	// closed once the server has acknowledged every write, or failed
+	done chan struct{}
+	// where the next write starts if the handle is written sequentially
+	next int64
+	// owned by the goroutine receiving acks until done is closed
+	committed int64
+	err       error
+}
+
+func (ws *writeStream) open(fsClient pb.FuseServiceClient) error {
+	// outlives the op that opened it, so is cancelled on flush instead
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := fsClient.WriteFileStream(ctx)
+	if err != nil {
+		cancel()
+		log.Print("writeStream.open - fsClient.WriteFileStream raised error. ", err)
+		return err
+	}
+	ws.stream, ws.cancel, ws.done = stream, cancel, make(chan struct{})
+	ws.committed, ws.err = ws.next, nil
+	go func() {
+		defer close(ws.done)
+		for {
+			ack, err := stream.Recv()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				log.Print("writeStream - stream.Recv raised error. ", err)
+				ws.err = err
+				return
+			}
+			ws.committed = ack.Offset
+		}
+	}()
+	return nil
+}
+
+// waits for the writes sent so far to be committed, closing the stream
+func (ws *writeStream) flush() error {
+	if ws.stream == nil {
+		return nil
+	}
+	ws.stream.CloseSend()
+	<-ws.done
+	ws.cancel()
+	ws.stream = nil
+	if ws.err != nil {
+		return ws.err
+	}
+	if ws.committed != ws.next {
+		log.Printf("writeStream.flush - committed up to %v of %v", ws.committed, ws.next)
+		return errors.New("short write")
+	}
+	return nil
+}
+
+// writes to an open file. Writes that continue the previous one are streamed,
+// and any other write goes out on its own once the stream is flushed.
+func (fs *grpcFs) write(ctx context.Context, inode fuseops.InodeID, handle fuseops.HandleID, data []byte, offset int64) error {
+	entry, _ := fs.writeStreams.LoadOrStore(handle, &writeStream{inode: inode})
+	ws := entry.(*writeStream)
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	if offset == ws.next {
+		if ws.stream == nil {
+			if err := ws.open(fs.client); err != nil {
+				return err
+			}
+		}
+		req := &pb.WriteFileReq{
+			Data:   data,
+			Offset: offset,
+			Handle: uint64(handle),
+		}
+		ws.next += int64(len(data))
+		// a failed send means the stream is broken, for a reason that
+		// flushing reports
+		if err := ws.stream.Send(req); err != nil {
+			return ws.flush()
+		}
+		return nil
+	}
+
+	if err := ws.flush(); err != nil {
+		return err
+	}
+	n, err := writeFile(fs.client, ctx, uint64(handle), data, offset)
+	ws.next = offset + n
+	if err != nil {
+		return err
+	}
+	// fuse has no notion of a partial write, so report it as a failure
+	if n != int64(len(data)) {
+		return errors.New("short write")
+	}
+	return nil
+}
+
+// flushes the writes streamed through a handle
+func (fs *grpcFs) flushHandle(handle fuseops.HandleID) error {
+	entry, found := fs.writeStreams.Load(handle)
+	if !found {
+		return nil
+	}
+	ws := entry.(*writeStream)
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	return ws.flush()
+}
+
+// flushes the writes streamed through any handle of an inode, before its
+// contents or attributes are looked at
+func (fs *grpcFs) flushInode(inode fuseops.InodeID) error {
+	var err error
+	fs.writeStreams.Range(func(handle, entry any) bool {
+		if entry.(*writeStream).inode == inode {
+			if flushErr := fs.flushHandle(handle.(fuseops.HandleID)); err == nil {
+				err = flushErr
+			}
+		}
+		return true
+	})
+	return err
+}
@@ -6,8 +6,10 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
+	"strings"
 
 	"grpcfs"
+	"grpcfs/pb"
 
 	"github.com/jacobsa/fuse"
 )
@@ -27,27 +29,75 @@ func logState(message string, v ...any) {
 func main() {
 
 	var mountPoint string
-	var servePath string
+	var serve string
+	var serverAddress string
+	var useTLS bool
+	var tlsCA string
+	var tlsCert string
+	var tlsKey string
+	var tlsServerName string
+	var rpcCtx pb.RPCContext
+	var tokenFile string
+	var config grpcfs.Config
 
 	flag.StringVar(&mountPoint, "mount", "", "Mount point")
-	flag.StringVar(&servePath, "serve", "", "Path to serve")
+	flag.StringVar(&serve, "serve", "", "Export to serve, optionally followed by a path within it, as name[/path]")
+	flag.StringVar(&serverAddress, "server", "127.0.0.1:50000", "Address of the server, as host:port or unix:///path/to/socket")
+	flag.Int64Var(&config.ChunkSize, "chunk-size", grpcfs.DefaultChunkSize, "Size of the chunks large reads are streamed in, at most 2MB")
+	flag.Int64Var(&config.ReadAhead, "readahead", grpcfs.DefaultReadAhead, "Bytes to fetch ahead of sequential reads, negative to disable")
+	flag.BoolVar(&config.ReadOnly, "readonly", false, "Mount read-only")
+	flag.BoolVar(&useTLS, "tls", false, "Connect over TLS, implied by the other tls flags")
+	flag.StringVar(&tlsCA, "tls-ca", "", "CA bundle to verify the server certificate against (defaults to the system roots)")
+	flag.StringVar(&tlsCert, "tls-cert", "", "Client certificate, for servers requiring one")
+	flag.StringVar(&tlsKey, "tls-key", "", "Private key of the client certificate")
+	flag.StringVar(&tlsServerName, "tls-server-name", "", "Name to verify the server certificate against (defaults to the server host)")
+	flag.StringVar(&rpcCtx.GatewayId, "gateway", os.Getenv("GRPCFS_GATEWAY_ID"), "Gateway the mount acts for (defaults to $GRPCFS_GATEWAY_ID)")
+	flag.StringVar(&rpcCtx.AgentId, "agent", os.Getenv("GRPCFS_AGENT_ID"), "Agent the mount acts as (defaults to $GRPCFS_AGENT_ID)")
+	flag.StringVar(&rpcCtx.AccessToken, "token", os.Getenv("GRPCFS_ACCESS_TOKEN"), "Access token to authenticate with (defaults to $GRPCFS_ACCESS_TOKEN)")
+	flag.StringVar(&tokenFile, "token-file", "", "File to read the access token from, when not given otherwise")
 	flag.Parse()
 
-	if mountPoint == "" || servePath == "" {
-		logger.Fatal("Please specify both mount point and path to serve")
+	if mountPoint == "" {
+		logger.Fatal("Please specify the mount point")
 	}
 
 	mountPoint, err := filepath.Abs(mountPoint)
 	handleErrIfAny(err, "Invalid mount point")
 
-	server, err := grpcfs.FuseServer("127.0.0.1:50000", servePath, logger)
+	creds := grpcfs.PlaintextCredentials(serverAddress)
+	if useTLS || tlsCA != "" || tlsCert != "" {
+		creds, err = grpcfs.TLSCredentials(tlsCA, tlsCert, tlsKey, tlsServerName)
+		handleErrIfAny(err, "Invalid TLS configuration")
+	}
+
+	if rpcCtx.AccessToken == "" && tokenFile != "" {
+		token, err := os.ReadFile(tokenFile)
+		handleErrIfAny(err, "Could not read token file")
+		rpcCtx.AccessToken = strings.TrimSpace(string(token))
+	}
+
+	opts := append(grpcfs.WithRPCContext(&rpcCtx), creds)
+
+	exportName, servePath, _ := strings.Cut(strings.TrimPrefix(serve, "/"), "/")
+	if exportName == "" {
+		exports, err := grpcfs.ListExports(serverAddress, opts...)
+		handleErrIfAny(err, "Could not list exports")
+		names := []string{}
+		for _, export := range exports {
+			names = append(names, export.Name)
+		}
+		logger.Fatalf("Please specify an export to serve, one of: %v", strings.Join(names, ", "))
+	}
+	rpcCtx.Export = exportName
+
+	server, err := grpcfs.FuseServer(serverAddress, "/"+servePath, config, logger, opts...)
 	handleErrIfAny(err, "Error starting fuse server")
 
 	cfg := &fuse.MountConfig{
 		FSName:      "grpcFS",
 		Subtype:     "airavata",
 		VolumeName:  "GRPC FS - Airavata",
-		ReadOnly:    false,
+		ReadOnly:    config.ReadOnly,
 		ErrorLogger: logger,
 	}
 	mfs, err := fuse.Mount(mountPoint, server, cfg)
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"grpcfs/pb"
+)
+
+// checks the credentials a request carries in its RPCContext
+type tokenValidator interface {
+	// returns the gateway the credentials are issued to, if any, which the
+	// request must name
+	validate(rpcCtx *pb.RPCContext) (gateway string, err error)
+	// whether any credentials can be issued to a gateway, without which
+	// the gateway a request names cannot be trusted
+	verifiesGateways() bool
+}
+
+// a fixed set of tokens, read from a file with one token per line, optionally
+// followed by the agent it is issued to, or - for any, and then the gateway.
+// Blank lines and lines starting with # are ignored.
+type staticTokens struct {
+	tokens []staticToken
+}
+
+type staticToken struct {
+	token   string
+	agent   string
+	gateway string
+}
+
+func newStaticTokens(path string) (*staticTokens, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	validator := &staticTokens{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		entry := staticToken{token: fields[0]}
+		if len(fields) > 1 && fields[1] != "-" {
+			entry.agent = fields[1]
+		}
+		if len(fields) > 2 {
+			entry.gateway = fields[2]
+		}
+		validator.tokens = append(validator.tokens, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(validator.tokens) == 0 {
+		return nil, fmt.Errorf("no tokens found in %v", path)
+	}
+	return validator, nil
+}
+
+func (v *staticTokens) validate(rpcCtx *pb.RPCContext) (string, error) {
+	token := []byte(rpcCtx.GetAccessToken())
+	for _, entry := range v.tokens {
+		// compares every token in constant time, so as not to leak how
+		// close a guess came
+		if subtle.ConstantTimeCompare(token, []byte(entry.token)) == 1 {
+			if entry.agent != "" && entry.agent != rpcCtx.GetAgentId() {
+				return "", fmt.Errorf("token is not issued to agent '%v'", rpcCtx.GetAgentId())
+			}
+			if entry.gateway != "" && entry.gateway != rpcCtx.GetGatewayId() {
+				return "", fmt.Errorf("token is not issued to gateway '%v'", rpcCtx.GetGatewayId())
+			}
+			return entry.gateway, nil
+		}
+	}
+	return "", errors.New("invalid access token")
+}
+
+func (v *staticTokens) verifiesGateways() bool {
+	for _, entry := range v.tokens {
+		if entry.gateway != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// JWTs signed with HMAC-SHA256 using a shared secret. Tokens must not be
+// expired, and when they name a subject or gateway, those must match the
+// agent and gateway of the request.
+type hmacJWT struct {
+	secret []byte
+}
+
+type jwtClaims struct {
+	Subject   string `json:"sub"`
+	Gateway   string `json:"gateway"`
+	ExpiresAt *int64 `json:"exp"`
+	NotBefore *int64 `json:"nbf"`
+}
+
+func newHMACJWT(secretFile string) (*hmacJWT, error) {
+	secret, err := os.ReadFile(secretFile)
+	if err != nil {
+		return nil, err
+	}
+	secret = []byte(strings.TrimSpace(string(secret)))
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("empty secret in %v", secretFile)
+	}
+	return &hmacJWT{secret: secret}, nil
+}
+
+func (v *hmacJWT) validate(rpcCtx *pb.RPCContext) (string, error) {
+	parts := strings.Split(rpcCtx.GetAccessToken(), ".")
+	if len(parts) != 3 {
+		return "", errors.New("malformed token")
+	}
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return "", err
+	}
+	// the algorithm is fixed, rather than taken from the token
+	if header.Alg != "HS256" {
+		return "", fmt.Errorf("unsupported token algorithm '%v'", header.Alg)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", errors.New("malformed token signature")
+	}
+	mac := hmac.New(sha256.New, v.secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return "", errors.New("invalid token signature")
+	}
+	var claims jwtClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return "", err
+	}
+	now := time.Now().Unix()
+	if claims.ExpiresAt == nil || now >= *claims.ExpiresAt {
+		return "", errors.New("token expired")
+	}
+	if claims.NotBefore != nil && now < *claims.NotBefore {
+		return "", errors.New("token not yet valid")
+	}
+	if claims.Subject != "" && claims.Subject != rpcCtx.GetAgentId() {
+		return "", fmt.Errorf("token is not issued to agent '%v'", rpcCtx.GetAgentId())
+	}
+	if claims.Gateway != "" && claims.Gateway != rpcCtx.GetGatewayId() {
+		return "", fmt.Errorf("token is not issued to gateway '%v'", rpcCtx.GetGatewayId())
+	}
+	return claims.Gateway, nil
+}
+
+// any token may carry a gateway claim
+func (v *hmacJWT) verifiesGateways() bool {
+	return true
+}
+
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return errors.New("malformed token")
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return errors.New("malformed token")
+	}
+	return nil
+}
+
+// rejects requests whose RPCContext fails validation. The gateway a request
+// names is only trusted when its credentials are issued to that gateway, and
+// is cleared otherwise, so that it cannot open exports limited to gateways.
+type authenticator struct {
+	validator tokenValidator
+}
+
+func (a *authenticator) authenticate(msg any) error {
+	request, ok := msg.(interface{ GetContext() *pb.RPCContext })
+	if !ok {
+		return status.Error(codes.Unauthenticated, "request carries no context")
+	}
+	rpcCtx := request.GetContext()
+	gateway, err := a.validator.validate(rpcCtx)
+	if err != nil {
+		logger.Printf("rejected request of agent '%v' on gateway '%v': %v", rpcCtx.GetAgentId(), rpcCtx.GetGatewayId(), err)
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	if rpcCtx != nil {
+		rpcCtx.GatewayId = gateway
+	}
+	return nil
+}
+
+func (a *authenticator) unaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := a.authenticate(req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (a *authenticator) streamInterceptor(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &authenticatedStream{ServerStream: stream, authenticator: a})
+}
+
+// authenticates each message received over a stream
+type authenticatedStream struct {
+	grpc.ServerStream
+	authenticator *authenticator
+}
+
+func (s *authenticatedStream) RecvMsg(msg any) error {
+	if err := s.ServerStream.RecvMsg(msg); err != nil {
+		return err
+	}
+	return s.authenticator.authenticate(msg)
+}
+
+// the context of a request as it is logged, without its access token
+func redacted(rpcCtx *pb.RPCContext) *pb.RPCContext {
+	return &pb.RPCContext{
+		GatewayId: rpcCtx.GetGatewayId(),
+		AgentId:   rpcCtx.GetAgentId(),
+		Export:    rpcCtx.GetExport(),
+	}
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"grpcfs/pb"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// signs a JWT with HMAC-SHA256, whatever algorithm its header names
+func signJWT(t *testing.T, secret string, header map[string]any, claims map[string]any) string {
+	t.Helper()
+	encode := func(v any) string {
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return base64.RawURLEncoding.EncodeToString(data)
+	}
+	signed := encode(header) + "." + encode(claims)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(signed))
+	return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func writeTempFile(t *testing.T, name string, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestHMACJWT(t *testing.T) {
+	const secret = "secret"
+	validator, err := newHMACJWT(writeTempFile(t, "secret", secret+"\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hs256 := map[string]any{"alg": "HS256", "typ": "JWT"}
+	now := time.Now().Unix()
+	valid := signJWT(t, secret, hs256, map[string]any{"exp": now + 60})
+	// the claims of one token, with the signature of another
+	other := strings.Split(signJWT(t, secret, hs256, map[string]any{"exp": now + 3600}), ".")
+	tampered := strings.Join(append(other[:2], strings.Split(valid, ".")[2]), ".")
+
+	tests := []struct {
+		name    string
+		token   string
+		agent   string
+		gateway string
+		// the error expected, if the token is to be rejected
+		want string
+	}{
+		{name: "valid", token: valid},
+		{name: "valid for its subject and gateway", agent: "agent", gateway: "gw",
+			token: signJWT(t, secret, hs256, map[string]any{"exp": now + 60, "nbf": now - 60, "sub": "agent", "gateway": "gw"})},
+		{name: "expired", want: "token expired",
+			token: signJWT(t, secret, hs256, map[string]any{"exp": now - 60})},
+		{name: "without expiry", agent: "agent", want: "token expired",
+			token: signJWT(t, secret, hs256, map[string]any{"sub": "agent"})},
+		{name: "not yet valid", want: "token not yet valid",
+			token: signJWT(t, secret, hs256, map[string]any{"exp": now + 120, "nbf": now + 60})},
+		{name: "unsigned", want: "unsupported token algorithm",
+			token: signJWT(t, secret, map[string]any{"alg": "none"}, map[string]any{"exp": now + 60})},
+		{name: "other algorithm", want: "unsupported token algorithm",
+			token: signJWT(t, secret, map[string]any{"alg": "HS512"}, map[string]any{"exp": now + 60})},
+		{name: "other secret", want: "invalid token signature",
+			token: signJWT(t, "other", hs256, map[string]any{"exp": now + 60})},
+		{name: "tampered claims", token: tampered, want: "invalid token signature"},
+		{name: "other subject", agent: "intruder", want: "not issued to agent",
+			token: signJWT(t, secret, hs256, map[string]any{"exp": now + 60, "sub": "agent"})},
+		{name: "other gateway", gateway: "intruder", want: "not issued to gateway",
+			token: signJWT(t, secret, hs256, map[string]any{"exp": now + 60, "gateway": "gw"})},
+		{name: "malformed", token: "not.a.token", want: "malformed token"},
+		{name: "missing", want: "malformed token"},
+	}
+	for _, test := range tests {
+		rpcCtx := &pb.RPCContext{AccessToken: test.token, AgentId: test.agent, GatewayId: test.gateway}
+		gateway, err := validator.validate(rpcCtx)
+		switch {
+		case test.want == "" && err != nil:
+			t.Errorf("%v: got %v, want the token accepted", test.name, err)
+		case test.want != "" && (err == nil || !strings.Contains(err.Error(), test.want)):
+			t.Errorf("%v: got %v, want %q", test.name, err, test.want)
+		case err == nil && gateway != test.gateway:
+			t.Errorf("%v: token vouches for gateway %q, want %q", test.name, gateway, test.gateway)
+		}
+	}
+}
+
+func TestStaticTokens(t *testing.T) {
+	validator, err := newStaticTokens(writeTempFile(t, "tokens", "# comment\n\nshared\nissued agent\ngw - gw\nboth agent gw\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !validator.verifiesGateways() {
+		t.Error("tokens issued to gateways not reported as such")
+	}
+	tests := []struct {
+		name    string
+		token   string
+		agent   string
+		gateway string
+		ok      bool
+	}{
+		{name: "shared token", token: "shared", agent: "anyone", ok: true},
+		{name: "issued token", token: "issued", agent: "agent", ok: true},
+		{name: "issued token of another agent", token: "issued", agent: "intruder"},
+		{name: "gateway token", token: "gw", agent: "anyone", gateway: "gw", ok: true},
+		{name: "gateway token on another gateway", token: "gw", gateway: "intruder"},
+		{name: "agent and gateway token", token: "both", agent: "agent", gateway: "gw", ok: true},
+		{name: "agent and gateway token of another agent", token: "both", agent: "intruder", gateway: "gw"},
+		{name: "unknown token", token: "unknown"},
+		{name: "prefix of a token", token: "share"},
+		{name: "comment", token: "#"},
+		{name: "missing"},
+	}
+	for _, test := range tests {
+		gateway, err := validator.validate(&pb.RPCContext{AccessToken: test.token, AgentId: test.agent, GatewayId: test.gateway})
+		if test.ok && err != nil {
+			t.Errorf("%v: got %v, want the token accepted", test.name, err)
+		}
+		if !test.ok && err == nil {
+			t.Errorf("%v: token accepted", test.name)
+		}
+		if err == nil && gateway != test.gateway {
+			t.Errorf("%v: token vouches for gateway %q, want %q", test.name, gateway, test.gateway)
+		}
+	}
+
+	if _, err := newStaticTokens(writeTempFile(t, "empty", "# no tokens\n")); err == nil {
+		t.Error("token file without tokens accepted")
+	}
+	withoutGateways, err := newStaticTokens(writeTempFile(t, "agents", "issued agent\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if withoutGateways.verifiesGateways() {
+		t.Error("tokens without gateways reported as issued to gateways")
+	}
+}
+
+func TestAuthenticatorGateway(t *testing.T) {
+	validator, err := newStaticTokens(writeTempFile(t, "tokens", "shared\ngw - gw\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth := &authenticator{validator: validator}
+	exp := &export{name: "restricted", gateways: map[string]bool{"gw": true}}
+	tests := []struct {
+		token   string
+		gateway string
+		allowed bool
+	}{
+		{token: "gw", gateway: "gw", allowed: true},
+		// a gateway named with a token not issued to it is not trusted
+		{token: "shared", gateway: "gw"},
+		{token: "shared"},
+	}
+	for _, test := range tests {
+		req := &pb.StatFsReq{Context: &pb.RPCContext{AccessToken: test.token, GatewayId: test.gateway}}
+		if err := auth.authenticate(req); err != nil {
+			t.Errorf("token %v: %v", test.token, err)
+			continue
+		}
+		if got := exp.allows(req.Context.GetGatewayId()); got != test.allowed {
+			t.Errorf("token %v on gateway %q: got allowed %v", test.token, test.gateway, got)
+		}
+	}
+}
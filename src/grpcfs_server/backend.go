@@ -0,0 +1,75 @@
+package main
+
+import (
+	"io"
+	"os"
+	"time"
+)
+
+// the storage an export is served from. Paths are relative to the root of the
+// backend, and never resolve outside of it.
+type Backend interface {
+	// opens a path with os.O_* flags, following symlinks
+	Open(path string, flag int, perm os.FileMode) (File, error)
+	// opens a path only to read and update its attributes, following symlinks
+	OpenAttrs(path string) (File, error)
+	// stats a path, reporting symlinks as such rather than as their targets
+	Lstat(path string) (FileInfo, error)
+	StatFs() (*FsStats, error)
+	Mkdir(path string, perm os.FileMode) error
+	Rmdir(path string) error
+	Unlink(path string) error
+	// replaces whatever newPath names, as rename(2) does
+	Rename(oldPath string, newPath string) error
+	Readlink(path string) (string, error)
+	Symlink(target string, path string) error
+	Link(oldPath string, newPath string) error
+	// extended attributes, which are never read through symlinks
+	GetXattr(path string, attr string) ([]byte, error)
+	ListXattr(path string) ([]string, error)
+	// flags are those of setxattr(2)
+	SetXattr(path string, attr string, value []byte, flags int) error
+	RemoveXattr(path string, attr string) error
+}
+
+// a file or directory opened from a backend
+type File interface {
+	io.ReaderAt
+	io.WriterAt
+	io.Closer
+	Stat() (FileInfo, error)
+	// lists a directory. The info of an entry is read when asked for, and
+	// fails with fs.ErrNotExist once the entry is gone.
+	ReadDir() ([]DirEntry, error)
+	Truncate(size int64) error
+	Chmod(mode os.FileMode) error
+	// zero times are left unchanged
+	Chtimes(atime time.Time, mtime time.Time) error
+}
+
+// the attributes of a file, along with those identifying it whatever path
+// reaches it
+type FileInfo interface {
+	os.FileInfo
+	Dev() uint64
+	Ino() uint64
+	Nlink() uint64
+}
+
+// an entry of a directory listed from a backend
+type DirEntry interface {
+	Name() string
+	IsDir() bool
+	Type() os.FileMode
+	Info() (FileInfo, error)
+}
+
+// the capacity and usage of a backend
+type FsStats struct {
+	BlockSize       uint32
+	Blocks          uint64
+	BlocksFree      uint64
+	BlocksAvailable uint64
+	Inodes          uint64
+	InodesFree      uint64
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"fmt"
+	"grpcfs/pb"
+	"strings"
+
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// a backend served under a name, along with its options
+type export struct {
+	name     string
+	backend  Backend
+	readOnly bool
+	// the gateways allowed to use the export, any when empty. Requests only
+	// name a gateway their token is issued to, the authenticator clearing
+	// any other.
+	gateways map[string]bool
+}
+
+// the exports of a server by name, set from repeated -export flags given as
+// name=path[,ro][,gateways=gw1:gw2], where a path of mem:[capacity] serves an
+// empty tree held in memory instead of a directory
+type exportsFlag map[string]*export
+
+func (e exportsFlag) String() string {
+	names := []string{}
+	for name := range e {
+		names = append(names, name)
+	}
+	return strings.Join(names, ",")
+}
+
+func (e exportsFlag) Set(spec string) error {
+	name, options, found := strings.Cut(spec, "=")
+	if !found || name == "" {
+		return fmt.Errorf("expected name=path, got '%v'", spec)
+	}
+	if _, found := e[name]; found {
+		return fmt.Errorf("export '%v' given twice", name)
+	}
+	fields := strings.Split(options, ",")
+	var backend Backend
+	if size, isMem := strings.CutPrefix(fields[0], "mem:"); isMem {
+		capacity, err := parseCapacity(size)
+		if err != nil {
+			return err
+		}
+		backend = newMemBackend(capacity)
+	} else {
+		var err error
+		backend, err = newLocalBackend(fields[0])
+		if err != nil {
+			return err
+		}
+	}
+	exp := &export{name: name, backend: backend, gateways: map[string]bool{}}
+	for _, option := range fields[1:] {
+		switch key, value, _ := strings.Cut(option, "="); key {
+		case "ro":
+			exp.readOnly = true
+		case "gateways":
+			for _, gateway := range strings.Split(value, ":") {
+				if gateway != "" {
+					exp.gateways[gateway] = true
+				}
+			}
+		default:
+			return fmt.Errorf("unknown option '%v' of export '%v'", option, name)
+		}
+	}
+	e[name] = exp
+	return nil
+}
+
+func (e *export) allows(gatewayId string) bool {
+	return len(e.gateways) == 0 || e.gateways[gatewayId]
+}
+
+// looks up the export a request names, which its gateway must be allowed to
+// use
+func (s *server) lookupExport(rpcCtx *pb.RPCContext) (*export, error) {
+	name := rpcCtx.GetExport()
+	exp, found := s.exports[name]
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no export '%v'", name)
+	}
+	if !exp.allows(rpcCtx.GetGatewayId()) {
+		return nil, status.Errorf(codes.PermissionDenied, "export '%v' is not available to gateway '%v'", name, rpcCtx.GetGatewayId())
+	}
+	return exp, nil
+}
+
+// looks up the export a request names like lookupExport, failing with EROFS
+// when the request modifies it and the export is read-only
+func (s *server) resolveExport(rpcCtx *pb.RPCContext, writable bool) (*export, error) {
+	exp, err := s.lookupExport(rpcCtx)
+	if err != nil {
+		return nil, err
+	}
+	if writable && exp.readOnly {
+		logger.Printf("rejected modification of read-only export '%v'", exp.name)
+		return nil, toStatus(fmt.Errorf("export '%v' is read-only: %w", exp.name, unix.EROFS))
+	}
+	return exp, nil
+}
+
+// resolves the backend of the export a request names
+func (s *server) exportFor(rpcCtx *pb.RPCContext) (Backend, error) {
+	exp, err := s.resolveExport(rpcCtx, false)
+	if err != nil {
+		return nil, err
+	}
+	return exp.backend, nil
+}
+
+// resolves the backend of the export a request modifying it names, failing
+// with EROFS when the export is read-only
+func (s *server) writableExportFor(rpcCtx *pb.RPCContext) (Backend, error) {
+	exp, err := s.resolveExport(rpcCtx, true)
+	if err != nil {
+		return nil, err
+	}
+	return exp.backend, nil
+}
@@ -6,6 +6,7 @@ replace grpcfs => ../grpcfs
 
 require (
 	golang.org/x/sys v0.22.0
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240528184218-531527333157
 	google.golang.org/grpc v1.65.0
 	google.golang.org/protobuf v1.34.2
 	grpcfs v0.0.0-00010101000000-000000000000
@@ -14,5 +15,4 @@ require (
 require (
 	golang.org/x/net v0.25.0 // indirect
 	golang.org/x/text v0.15.0 // indirect
-	google.golang.org/genproto/googleapis/rpc v0.0.0-20240528184218-531527333157 // indirect
 )
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"context"
+	"grpcfs/pb"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/status"
+)
+
+// a file or directory held open on behalf of a client
+type openHandle struct {
+	file File
+	// the export the handle was opened on, which requests using it must name
+	export *export
+	// directory entries and their stats, read once when the directory is
+	// opened so that paging through them stays consistent
+	entries []*pb.DirEntry
+	// the agent that opened the handle, and the connection it came through
+	owner    string
+	conn     uint64
+	lastUsed time.Time
+}
+
+type handleTable struct {
+	mu      sync.Mutex
+	next    uint64
+	handles map[uint64]*openHandle
+}
+
+func newHandleTable() *handleTable {
+	return &handleTable{
+		handles: map[uint64]*openHandle{},
+	}
+}
+
+// registers a handle opened by the given agent over the connection of ctx,
+// returning its id. Ids start at 1, so that 0 can stand for "no handle" in
+// requests.
+func (t *handleTable) add(ctx context.Context, owner string, handle *openHandle) uint64 {
+	handle.owner = owner
+	handle.conn, _ = ctx.Value(connKey{}).(uint64)
+	handle.lastUsed = time.Now()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.next++
+	t.handles[t.next] = handle
+	return t.next
+}
+
+// looks up a handle on behalf of the given agent, which must own it
+func (t *handleTable) get(id uint64, owner string) (*openHandle, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.lookup(id, owner)
+}
+
+// looks up a handle owned by the given agent, with the table locked
+func (t *handleTable) lookup(id uint64, owner string) (*openHandle, error) {
+	handle, found := t.handles[id]
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no open handle %v", id)
+	}
+	if handle.owner != owner {
+		return nil, status.Errorf(codes.PermissionDenied, "handle %v is not owned by '%v'", id, owner)
+	}
+	handle.lastUsed = time.Now()
+	return handle, nil
+}
+
+func (t *handleTable) release(id uint64, owner string) error {
+	t.mu.Lock()
+	handle, err := t.lookup(id, owner)
+	if err != nil {
+		t.mu.Unlock()
+		return err
+	}
+	delete(t.handles, id)
+	t.mu.Unlock()
+	return handle.file.Close()
+}
+
+// closes every handle matching the given condition
+func (t *handleTable) releaseIf(cond func(handle *openHandle) bool) int {
+	t.mu.Lock()
+	released := []*openHandle{}
+	for id, handle := range t.handles {
+		if cond(handle) {
+			released = append(released, handle)
+			delete(t.handles, id)
+		}
+	}
+	t.mu.Unlock()
+	for _, handle := range released {
+		handle.file.Close()
+	}
+	return len(released)
+}
+
+// closes the handles left open by a connection that went away
+func (t *handleTable) releaseConn(conn uint64) {
+	n := t.releaseIf(func(handle *openHandle) bool {
+		return handle.conn == conn
+	})
+	logger.Printf("released %v handles of closed connection %v, %v still open", n, conn, t.count())
+}
+
+// periodically closes handles that have been idle for longer than timeout
+func (t *handleTable) expire(timeout time.Duration) {
+	for range time.Tick(timeout / 2) {
+		deadline := time.Now().Add(-timeout)
+		n := t.releaseIf(func(handle *openHandle) bool {
+			return handle.lastUsed.Before(deadline)
+		})
+		logger.Printf("expired %v idle handles, %v still open", n, t.count())
+	}
+}
+
+// periodically logs how many handles are open, whether or not they expire
+func (t *handleTable) report(interval time.Duration) {
+	for range time.Tick(interval) {
+		logger.Printf("%v handles open", t.count())
+	}
+}
+
+func (t *handleTable) count() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.handles)
+}
+
+type connKey struct{}
+
+// tags each client connection with an id, and releases the handles opened
+// through it once it closes
+type connTracker struct {
+	handles *handleTable
+	next    atomic.Uint64
+}
+
+func (c *connTracker) TagConn(ctx context.Context, info *stats.ConnTagInfo) context.Context {
+	return context.WithValue(ctx, connKey{}, c.next.Add(1))
+}
+
+func (c *connTracker) HandleConn(ctx context.Context, s stats.ConnStats) {
+	if _, ok := s.(*stats.ConnEnd); ok {
+		c.handles.releaseConn(ctx.Value(connKey{}).(uint64))
+	}
+}
+
+func (c *connTracker) TagRPC(ctx context.Context, info *stats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+func (c *connTracker) HandleRPC(ctx context.Context, s stats.RPCStats) {}
@@ -0,0 +1,401 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// a backend serving a directory tree on the local disk. Request paths are
+// resolved beneath it, never escaping through .. or symlinks.
+type localBackend struct {
+	path string
+	dir  *os.File
+	// set on kernels without openat2, where paths are checked lexically
+	// instead, which is open to races with concurrent renames on the host
+	lexical bool
+	// the root with its own symlinks resolved, for lexical checks
+	resolved string
+}
+
+func newLocalBackend(path string) (*localBackend, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	dir, err := os.OpenFile(path, unix.O_PATH|unix.O_DIRECTORY, 0)
+	if err != nil {
+		return nil, err
+	}
+	backend := &localBackend{path: path, dir: dir}
+	backend.resolved, err = filepath.EvalSymlinks(path)
+	if err != nil {
+		dir.Close()
+		return nil, err
+	}
+	fd, err := unix.Openat2(int(dir.Fd()), ".", &unix.OpenHow{Flags: unix.O_PATH | unix.O_CLOEXEC})
+	if errors.Is(err, unix.ENOSYS) {
+		logger.Print("openat2 is not available, checking paths lexically")
+		backend.lexical = true
+	} else if err == nil {
+		unix.Close(fd)
+	}
+	return backend, nil
+}
+
+// the relative form of a request path, with any .. at its start dropped
+func relative(path string) string {
+	rel := strings.TrimPrefix(filepath.Clean("/"+path), "/")
+	if rel == "" {
+		return "."
+	}
+	return rel
+}
+
+func (b *localBackend) Open(path string, flag int, perm os.FileMode) (File, error) {
+	file, err := b.open(path, flag, perm)
+	if err != nil {
+		return nil, err
+	}
+	return localFile{file}, nil
+}
+
+func (b *localBackend) OpenAttrs(path string) (File, error) {
+	return b.Open(path, unix.O_PATH, 0)
+}
+
+// opens a path beneath the root, following symlinks only as long as they stay
+// beneath it
+func (b *localBackend) open(path string, flag int, perm os.FileMode) (*os.File, error) {
+	rel := relative(path)
+	if b.lexical {
+		return b.openLexical(path, rel, flag, perm)
+	}
+	how := &unix.OpenHow{
+		Flags:   uint64(flag | unix.O_CLOEXEC),
+		Resolve: unix.RESOLVE_BENEATH | unix.RESOLVE_NO_MAGICLINKS,
+	}
+	// unlike open(2), openat2 rejects a mode when nothing is created
+	if flag&unix.O_CREAT != 0 {
+		how.Mode = uint64(perm.Perm())
+	}
+	for {
+		fd, err := unix.Openat2(int(b.dir.Fd()), rel, how)
+		// raised when the tree changed during the lookup
+		if err == unix.EAGAIN {
+			continue
+		}
+		// raised when the lookup would have left the root
+		if err == unix.EXDEV {
+			err = unix.EACCES
+		}
+		if err != nil {
+			return nil, &os.PathError{Op: "open", Path: path, Err: err}
+		}
+		return os.NewFile(uintptr(fd), filepath.Base("/"+rel)), nil
+	}
+}
+
+func (b *localBackend) openLexical(path string, rel string, flag int, perm os.FileMode) (*os.File, error) {
+	full := filepath.Join(b.path, rel)
+	// the last component is not resolved when it is not followed
+	checked := full
+	if flag&unix.O_NOFOLLOW != 0 && rel != "." {
+		checked = filepath.Dir(full)
+	}
+	resolved, err := filepath.EvalSymlinks(checked)
+	// nor when it is about to be created, unless it is a dangling symlink,
+	// which would be created wherever it points
+	if errors.Is(err, fs.ErrNotExist) && flag&unix.O_CREAT != 0 && checked == full {
+		if _, err := os.Lstat(full); err == nil {
+			return nil, &os.PathError{Op: "open", Path: path, Err: unix.EACCES}
+		}
+		resolved, err = filepath.EvalSymlinks(filepath.Dir(full))
+	}
+	if err == nil && resolved != b.resolved && !strings.HasPrefix(resolved, b.resolved+"/") {
+		return nil, &os.PathError{Op: "open", Path: path, Err: unix.EACCES}
+	}
+	file, err := os.OpenFile(full, flag, perm)
+	// errors name the request path rather than the one on the host
+	var pathErr *os.PathError
+	if errors.As(err, &pathErr) {
+		pathErr.Path = path
+	}
+	return file, err
+}
+
+// opens the directory holding a path, for the *at syscalls that act on the
+// last component itself
+func (b *localBackend) openParent(path string) (*os.File, string, error) {
+	rel := relative(path)
+	dir, err := b.open(filepath.Dir(rel), unix.O_PATH|unix.O_DIRECTORY, 0)
+	if err != nil {
+		return nil, "", err
+	}
+	return dir, filepath.Base(rel), nil
+}
+
+// runs an *at syscall on the last component of a path
+func (b *localBackend) at(op string, path string, call func(dirfd int, name string) error) error {
+	dir, name, err := b.openParent(path)
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+	if err := call(int(dir.Fd()), name); err != nil {
+		return &os.PathError{Op: op, Path: path, Err: err}
+	}
+	return nil
+}
+
+// stats a path, reporting symlinks as such rather than as their targets
+func (b *localBackend) Lstat(path string) (FileInfo, error) {
+	file, err := b.open(path, unix.O_PATH|unix.O_NOFOLLOW, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return localStat(file)
+}
+
+// stats an entry of an open directory, without following it. Unlike
+// os.DirEntry.Info, this does not go through the path the directory was
+// opened with.
+func lstatAt(dir *os.File, name string) (FileInfo, error) {
+	fd, err := unix.Openat(int(dir.Fd()), name, unix.O_PATH|unix.O_NOFOLLOW|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return nil, &os.PathError{Op: "lstat", Path: name, Err: err}
+	}
+	file := os.NewFile(uintptr(fd), name)
+	defer file.Close()
+	return localStat(file)
+}
+
+// the attributes of a local file, identified by the inode it has on disk
+type localFileInfo struct {
+	os.FileInfo
+	stat *syscall.Stat_t
+}
+
+func localStat(file *os.File) (FileInfo, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return localFileInfo{info, info.Sys().(*syscall.Stat_t)}, nil
+}
+
+func (i localFileInfo) Dev() uint64   { return i.stat.Dev }
+func (i localFileInfo) Ino() uint64   { return i.stat.Ino }
+func (i localFileInfo) Nlink() uint64 { return uint64(i.stat.Nlink) }
+
+func (b *localBackend) Mkdir(path string, perm os.FileMode) error {
+	return b.at("mkdir", path, func(dirfd int, name string) error {
+		return unix.Mkdirat(dirfd, name, uint32(perm.Perm()))
+	})
+}
+
+// unlike os.Remove, this never falls back to deleting a file
+func (b *localBackend) Rmdir(path string) error {
+	return b.at("rmdir", path, func(dirfd int, name string) error {
+		return unix.Unlinkat(dirfd, name, unix.AT_REMOVEDIR)
+	})
+}
+
+// unlike os.Remove, this never falls back to removing a directory
+func (b *localBackend) Unlink(path string) error {
+	return b.at("unlink", path, func(dirfd int, name string) error {
+		return unix.Unlinkat(dirfd, name, 0)
+	})
+}
+
+// os.Rename refuses to replace an existing directory, rename(2) does not
+func (b *localBackend) Rename(oldPath string, newPath string) error {
+	return b.at("rename", oldPath, func(oldDirfd int, oldName string) error {
+		return b.at("rename", newPath, func(newDirfd int, newName string) error {
+			return unix.Renameat(oldDirfd, oldName, newDirfd, newName)
+		})
+	})
+}
+
+func (b *localBackend) Readlink(path string) (string, error) {
+	var target string
+	err := b.at("readlink", path, func(dirfd int, name string) error {
+		for size := 256; ; size *= 2 {
+			dst := make([]byte, size)
+			n, err := unix.Readlinkat(dirfd, name, dst)
+			if err != nil {
+				return err
+			}
+			if n < size {
+				target = string(dst[:n])
+				return nil
+			}
+		}
+	})
+	return target, err
+}
+
+// the target is stored as is, and only ever followed beneath the root
+func (b *localBackend) Symlink(target string, path string) error {
+	return b.at("symlink", path, func(dirfd int, name string) error {
+		return unix.Symlinkat(target, dirfd, name)
+	})
+}
+
+func (b *localBackend) Link(oldPath string, newPath string) error {
+	return b.at("link", oldPath, func(oldDirfd int, oldName string) error {
+		return b.at("link", newPath, func(newDirfd int, newName string) error {
+			return unix.Linkat(oldDirfd, oldName, newDirfd, newName, 0)
+		})
+	})
+}
+
+// the path of an open file through procfs, for the syscalls that only take
+// paths yet must not resolve the original path again
+func fdPath(file *os.File) string {
+	return fmt.Sprintf("/proc/self/fd/%d", file.Fd())
+}
+
+// runs an l*xattr syscall on a path, through its parent directory, so that
+// the last component is not followed
+func (b *localBackend) xattr(op string, path string, call func(path string) error) error {
+	return b.at(op, path, func(dirfd int, name string) error {
+		return call(fmt.Sprintf("/proc/self/fd/%d/%s", dirfd, name))
+	})
+}
+
+func (b *localBackend) GetXattr(path string, attr string) ([]byte, error) {
+	var value []byte
+	err := b.xattr("lgetxattr", path, func(path string) error {
+		var err error
+		value, err = readXattr(func(dst []byte) (int, error) {
+			return unix.Lgetxattr(path, attr, dst)
+		})
+		return err
+	})
+	return value, err
+}
+
+func (b *localBackend) ListXattr(path string) ([]string, error) {
+	var names []byte
+	err := b.xattr("llistxattr", path, func(path string) error {
+		var err error
+		names, err = readXattr(func(dst []byte) (int, error) {
+			return unix.Llistxattr(path, dst)
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	// names come back as a sequence of NUL-terminated strings
+	attrs := []string{}
+	for _, name := range strings.Split(string(names), "\x00") {
+		if name != "" {
+			attrs = append(attrs, name)
+		}
+	}
+	return attrs, nil
+}
+
+func (b *localBackend) SetXattr(path string, attr string, value []byte, flags int) error {
+	return b.xattr("lsetxattr", path, func(path string) error {
+		return unix.Lsetxattr(path, attr, value, flags)
+	})
+}
+
+func (b *localBackend) RemoveXattr(path string, attr string) error {
+	return b.xattr("lremovexattr", path, func(path string) error {
+		return unix.Lremovexattr(path, attr)
+	})
+}
+
+// calls an xattr syscall with a buffer large enough for its result, retrying
+// if the value grows between the size query and the actual read
+func readXattr(call func(dst []byte) (int, error)) ([]byte, error) {
+	for {
+		size, err := call(nil)
+		if err != nil {
+			return nil, err
+		}
+		dst := make([]byte, size)
+		n, err := call(dst)
+		if err == unix.ERANGE {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return dst[:n], nil
+	}
+}
+
+// reports the usage of the filesystem holding the root
+func (b *localBackend) StatFs() (*FsStats, error) {
+	stat := &unix.Statfs_t{}
+	if err := unix.Fstatfs(int(b.dir.Fd()), stat); err != nil {
+		return nil, &os.PathError{Op: "statfs", Path: "/", Err: err}
+	}
+	return &FsStats{
+		BlockSize:       uint32(stat.Bsize),
+		Blocks:          stat.Blocks,
+		BlocksFree:      stat.Bfree,
+		BlocksAvailable: stat.Bavail,
+		Inodes:          stat.Files,
+		InodesFree:      stat.Ffree,
+	}, nil
+}
+
+// a file opened beneath a local backend
+type localFile struct {
+	*os.File
+}
+
+func (f localFile) Stat() (FileInfo, error) {
+	return localStat(f.File)
+}
+
+func (f localFile) ReadDir() ([]DirEntry, error) {
+	dirEntries, err := f.File.ReadDir(-1)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]DirEntry, len(dirEntries))
+	for i, entry := range dirEntries {
+		entries[i] = localDirEntry{entry, f.File}
+	}
+	return entries, nil
+}
+
+// attributes are set through procfs, which also works for files opened with
+// O_PATH, or without write access
+func (f localFile) Truncate(size int64) error {
+	return os.Truncate(fdPath(f.File), size)
+}
+
+func (f localFile) Chmod(mode os.FileMode) error {
+	return os.Chmod(fdPath(f.File), mode)
+}
+
+func (f localFile) Chtimes(atime time.Time, mtime time.Time) error {
+	return os.Chtimes(fdPath(f.File), atime, mtime)
+}
+
+// an entry of a local directory, stated through the directory it was read
+// from
+type localDirEntry struct {
+	os.DirEntry
+	dir *os.File
+}
+
+func (e localDirEntry) Info() (FileInfo, error) {
+	return lstatAt(e.dir, e.Name())
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+// lays out a root to serve, next to a directory it must not reach:
+//
+//	outside/secret
+//	root/file
+//	root/dir/inner
+//	root/inlink -> dir
+//	root/abs -> <outside>/secret
+//	root/rel -> ../outside/secret
+//	root/dangling -> ../outside/created
+//	root/dirlink -> ../outside
+func newLocalTestTree(t *testing.T) (root string, outside string) {
+	t.Helper()
+	base := t.TempDir()
+	root = filepath.Join(base, "root")
+	outside = filepath.Join(base, "outside")
+	for _, dir := range []string{outside, filepath.Join(root, "dir")} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	files := map[string]string{
+		filepath.Join(outside, "secret"):    "secret",
+		filepath.Join(root, "file"):         "file",
+		filepath.Join(root, "dir", "inner"): "inner",
+	}
+	for path, data := range files {
+		if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	links := map[string]string{
+		"inlink":   "dir",
+		"abs":      filepath.Join(outside, "secret"),
+		"rel":      "../outside/secret",
+		"dangling": "../outside/created",
+		"dirlink":  "../outside",
+	}
+	for name, target := range links {
+		if err := os.Symlink(target, filepath.Join(root, name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root, outside
+}
+
+func TestLocalConfinement(t *testing.T) {
+	for _, lexical := range []bool{false, true} {
+		name := "openat2"
+		if lexical {
+			name = "lexical"
+		}
+		t.Run(name, func(t *testing.T) {
+			testLocalConfinement(t, lexical)
+		})
+	}
+}
+
+func testLocalConfinement(t *testing.T, lexical bool) {
+	root, outside := newLocalTestTree(t)
+	backend, err := newLocalBackend(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if backend.lexical && !lexical {
+		t.Skip("openat2 is not available")
+	}
+	backend.lexical = lexical
+
+	opens := []struct {
+		path string
+		flag int
+		// the content read, or the error expected
+		want    string
+		wantErr error
+	}{
+		{path: "file", want: "file"},
+		{path: "dir/inner", want: "inner"},
+		{path: "inlink/inner", want: "inner"},
+		{path: "dir/../file", want: "file"},
+		// .. never leads above the root, wherever it appears
+		{path: "../outside/secret", wantErr: unix.ENOENT},
+		{path: "/../../outside/secret", wantErr: unix.ENOENT},
+		{path: "dir/../../outside/secret", wantErr: unix.ENOENT},
+		{path: "abs", wantErr: unix.EACCES},
+		{path: "rel", wantErr: unix.EACCES},
+		{path: "dirlink/secret", wantErr: unix.EACCES},
+		{path: "inlink/../rel", wantErr: unix.EACCES},
+		{path: "rel", flag: os.O_RDWR | os.O_CREATE, wantErr: unix.EACCES},
+		{path: "dangling", flag: os.O_RDWR | os.O_CREATE, wantErr: unix.EACCES},
+		{path: "dirlink/created", flag: os.O_RDWR | os.O_CREATE, wantErr: unix.EACCES},
+		{path: "rel", flag: os.O_RDONLY | unix.O_NOFOLLOW, wantErr: unix.ELOOP},
+	}
+	for _, test := range opens {
+		file, err := backend.Open(test.path, test.flag, 0644)
+		if test.wantErr != nil {
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("open %v: got %v, want %v", test.path, err, test.wantErr)
+			}
+			if err == nil {
+				file.Close()
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("open %v: %v", test.path, err)
+			continue
+		}
+		dst := make([]byte, 64)
+		n, _ := file.ReadAt(dst, 0)
+		file.Close()
+		if got := string(dst[:n]); got != test.want {
+			t.Errorf("read %v: got %q, want %q", test.path, got, test.want)
+		}
+	}
+
+	// symlinks are stated as such, though their targets are out of reach
+	if info, err := backend.Lstat("abs"); err != nil || info.Mode()&os.ModeSymlink == 0 {
+		t.Errorf("lstat abs: got %v, %v", info, err)
+	}
+	if _, err := backend.Lstat("dirlink/secret"); !errors.Is(err, unix.EACCES) {
+		t.Errorf("lstat dirlink/secret: got %v, want EACCES", err)
+	}
+
+	// the *at syscalls act on the last component, but only beneath the root
+	ats := []struct {
+		op   string
+		call func() error
+	}{
+		{"mkdir", func() error { return backend.Mkdir("dirlink/dir", 0755) }},
+		{"rmdir", func() error { return backend.Rmdir("dirlink/dir") }},
+		{"unlink", func() error { return backend.Unlink("dirlink/secret") }},
+		{"rename from", func() error { return backend.Rename("dirlink/secret", "stolen") }},
+		{"rename to", func() error { return backend.Rename("file", "dirlink/secret") }},
+		{"symlink", func() error { return backend.Symlink("/", "dirlink/link") }},
+		{"link from", func() error { return backend.Link("dirlink/secret", "stolen") }},
+		{"link to", func() error { return backend.Link("file", "dirlink/link") }},
+		{"readlink", func() error { _, err := backend.Readlink("dirlink/link"); return err }},
+		{"setxattr", func() error { return backend.SetXattr("dirlink/secret", "user.k", []byte("v"), 0) }},
+		{"getxattr", func() error { _, err := backend.GetXattr("dirlink/secret", "user.k"); return err }},
+		{"listxattr", func() error { _, err := backend.ListXattr("dirlink/secret"); return err }},
+		{"removexattr", func() error { return backend.RemoveXattr("dirlink/secret", "user.k") }},
+	}
+	for _, test := range ats {
+		if err := test.call(); !errors.Is(err, unix.EACCES) {
+			t.Errorf("%v through dirlink: got %v, want EACCES", test.op, err)
+		}
+	}
+
+	// the tree outside is left as it was
+	entries, err := os.ReadDir(outside)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Name() != "secret" {
+		t.Errorf("entries created outside the root: %v", entries)
+	}
+	if data, _ := os.ReadFile(filepath.Join(outside, "secret")); string(data) != "secret" {
+		t.Errorf("secret changed to %q", data)
+	}
+
+	// the symlinks themselves are removed, rather than their targets
+	for _, name := range []string{"rel", "dirlink"} {
+		if err := backend.Unlink(name); err != nil {
+			t.Errorf("unlink %v: %v", name, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(outside, "secret")); err != nil {
+		t.Errorf("secret removed through a symlink: %v", err)
+	}
+}
@@ -2,21 +2,40 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"grpcfs/pb"
+	"io"
+	"io/fs"
 	"log"
 	"net"
 	"os"
 	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
 	"syscall"
+	"time"
 
 	"golang.org/x/sys/unix"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var logger = log.Default()
 
+const (
+	// streamed reads are split into chunks of this size unless asked otherwise
+	defaultChunkSize = 1 << 20
+	// keeps each chunk well under the 4MB message limit of grpc
+	maxChunkSize = 2 << 20
+)
+
 func handleErr(err error, message string) error {
 	if err != nil {
 		logger.Printf("%s: %v\n", message, err)
@@ -29,25 +48,158 @@ func logState(message string, v ...any) {
 	logger.Print(message, v)
 }
 
+// the domain of the error details carrying the errno behind a failure
+const errorDomain = "grpcfs"
+
+// maps an error to a grpc status, passing the errno behind it, if any, in
+// the status details so that the client can report it as is
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	var errno syscall.Errno
+	if !errors.As(err, &errno) {
+		if errors.Is(err, fs.ErrNotExist) {
+			return status.Error(codes.NotFound, err.Error())
+		}
+		return status.Error(codes.Unknown, err.Error())
+	}
+	st := status.New(codeOf(errno), err.Error())
+	detailed, detailErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   unix.ErrnoName(errno),
+		Domain:   errorDomain,
+		Metadata: map[string]string{"errno": strconv.Itoa(int(errno))},
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// the status code closest to an errno, for clients that ignore the details
+func codeOf(errno syscall.Errno) codes.Code {
+	switch errno {
+	case unix.ENOENT, unix.ENODATA:
+		return codes.NotFound
+	case unix.EACCES, unix.EPERM:
+		return codes.PermissionDenied
+	case unix.EEXIST:
+		return codes.AlreadyExists
+	case unix.ENOTEMPTY, unix.ENOTDIR, unix.EISDIR, unix.EXDEV, unix.EBUSY, unix.EROFS:
+		return codes.FailedPrecondition
+	case unix.ENOSPC, unix.EDQUOT, unix.EFBIG, unix.EMFILE, unix.ENFILE:
+		return codes.ResourceExhausted
+	case unix.EINVAL, unix.ENAMETOOLONG, unix.ERANGE, unix.E2BIG, unix.EBADF:
+		return codes.InvalidArgument
+	case unix.ENOTSUP, unix.ENOSYS:
+		return codes.Unimplemented
+	}
+	return codes.Unknown
+}
+
+func toFileInfo(fileInfo FileInfo) *pb.FileInfo {
+	return &pb.FileInfo{
+		Name:    fileInfo.Name(),
+		Size:    fileInfo.Size(),
+		Mode:    uint32(fileInfo.Mode()),
+		ModTime: timestamppb.New(fileInfo.ModTime()),
+		IsDir:   fileInfo.IsDir(),
+		Ino:     fileInfo.Ino(),
+		Nlink:   fileInfo.Nlink(),
+		Dev:     fileInfo.Dev(),
+	}
+}
+
 type server struct {
 	pb.FuseServiceServer
+	handles *handleTable
+	exports exportsFlag
+}
+
+// looks up a handle on behalf of a request, which must name the export the
+// handle was opened on and, when writable is set, be allowed to modify it
+func (s *server) handleFor(ctx context.Context, rpcCtx *pb.RPCContext, id uint64, writable bool) (*openHandle, error) {
+	exp, err := s.resolveExport(rpcCtx, writable)
+	if err != nil {
+		return nil, err
+	}
+	handle, err := s.handles.get(id, ownerOf(ctx, rpcCtx))
+	if err != nil {
+		return nil, err
+	}
+	if handle.export != exp {
+		return nil, status.Errorf(codes.PermissionDenied, "handle %v is not open on export '%v'", id, exp.name)
+	}
+	return handle, nil
+}
+
+// returns the file a request refers to, going through its handle when it has
+// one. The returned func closes the file if it was opened just for the request.
+func (s *server) fileFor(ctx context.Context, rpcCtx *pb.RPCContext, handle uint64, path string, flag int) (File, func(), error) {
+	if handle != 0 {
+		openHandle, err := s.handleFor(ctx, rpcCtx, handle, flag&syscall.O_ACCMODE != os.O_RDONLY)
+		if err != nil {
+			return nil, nil, err
+		}
+		return openHandle.file, func() {}, nil
+	}
+	backend, err := s.exportFor(rpcCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+	file, err := backend.Open(path, flag, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	return file, func() { file.Close() }, nil
+}
+
+func (s *server) ListExports(ctx context.Context, req *pb.ListExportsReq) (*pb.ListExportsRes, error) {
+	rpcCtx := req.Context
+	logger.Print("received valid ListExports request. ", redacted(rpcCtx))
+	exports := []*pb.ExportInfo{}
+	for _, exp := range s.exports {
+		// exports the gateway may not use are not disclosed
+		if exp.allows(rpcCtx.GetGatewayId()) {
+			exports = append(exports, &pb.ExportInfo{
+				Name:     exp.name,
+				ReadOnly: exp.readOnly,
+			})
+		}
+	}
+	sort.Slice(exports, func(i, j int) bool {
+		return exports[i].Name < exports[j].Name
+	})
+	res := &pb.ListExportsRes{
+		Result: exports,
+	}
+	return res, nil
 }
 
 func (s *server) StatFs(ctx context.Context, req *pb.StatFsReq) (*pb.StatFsRes, error) {
 	path := req.Name
 	rpcCtx := req.Context
-	logger.Print("received valid StatFS request. ", path, rpcCtx)
-	stat := &unix.Statfs_t{}
-	unix.Statfs(req.Name, stat)
+	logger.Print("received valid StatFS request. ", path, redacted(rpcCtx))
+	backend, err := s.exportFor(rpcCtx)
+	if err != nil {
+		return nil, err
+	}
+	stat, err := backend.StatFs()
+	if handleErr(err, "backend.StatFs failed") != nil {
+		return nil, toStatus(err)
+	}
 	res := &pb.StatFsRes{
 		Result: &pb.StatFs{
-			BlockSize:       uint32(stat.Bsize),
+			BlockSize:       stat.BlockSize,
 			Blocks:          stat.Blocks,
-			BlocksFree:      stat.Bfree,
-			BlocksAvailable: stat.Bavail,
-			IoSize:          uint32(stat.Bsize),
-			InodesFree:      stat.Ffree,
-			Inodes:          stat.Files,
+			BlocksFree:      stat.BlocksFree,
+			BlocksAvailable: stat.BlocksAvailable,
+			IoSize:          stat.BlockSize,
+			InodesFree:      stat.InodesFree,
+			Inodes:          stat.Inodes,
 		},
 	}
 	return res, nil
@@ -56,44 +208,106 @@ func (s *server) StatFs(ctx context.Context, req *pb.StatFsReq) (*pb.StatFsRes,
 func (s *server) FileInfo(ctx context.Context, req *pb.FileInfoReq) (*pb.FileInfoRes, error) {
 	path := req.Name
 	rpcCtx := req.Context
-	logger.Print("received valid FileInfo request. ", path, rpcCtx)
-	fileInfo, err := os.Stat(path)
-	if handleErr(err, "os.Stat failed") != nil {
+	handle := req.Handle
+	logger.Print("received valid FileInfo request. ", path, redacted(rpcCtx), handle)
+	backend, err := s.exportFor(rpcCtx)
+	if err != nil {
 		return nil, err
 	}
-	stat := fileInfo.Sys().(*syscall.Stat_t)
+	var fileInfo FileInfo
+	if handle != 0 {
+		// reaches files that have been unlinked while open
+		var opened *openHandle
+		opened, err = s.handleFor(ctx, rpcCtx, handle, false)
+		if err != nil {
+			return nil, err
+		}
+		fileInfo, err = opened.file.Stat()
+	} else {
+		// symlinks are reported as such, rather than as their targets
+		fileInfo, err = backend.Lstat(path)
+	}
+	if handleErr(err, "stat failed") != nil {
+		return nil, toStatus(err)
+	}
 	res := &pb.FileInfoRes{
-		Result: &pb.FileInfo{
-			Name:    fileInfo.Name(),
-			Size:    fileInfo.Size(),
-			Mode:    uint32(fileInfo.Mode()),
-			ModTime: timestamppb.New(fileInfo.ModTime()),
-			IsDir:   fileInfo.IsDir(),
-			Ino:     stat.Ino,
-		},
+		Result: toFileInfo(fileInfo),
 	}
 	logger.Print("responded valid FileInfo. ", res.Result)
 	return res, nil
 }
 
-// TODO implement any locks here
 func (s *server) OpenDir(ctx context.Context, req *pb.OpenDirReq) (*pb.OpenDirRes, error) {
 	path := req.Name
 	rpcCtx := req.Context
-	logger.Print("received valid OpenDir request. ", path, rpcCtx)
+	logger.Print("received valid OpenDir request. ", path, redacted(rpcCtx))
+	exp, err := s.resolveExport(rpcCtx, false)
+	if err != nil {
+		return nil, err
+	}
+	file, err := exp.backend.Open(path, os.O_RDONLY, 0)
+	if handleErr(err, "backend.Open failed") != nil {
+		return nil, toStatus(err)
+	}
+	entries, err := listDir(file)
+	if err != nil {
+		file.Close()
+		return nil, toStatus(err)
+	}
+	handle := s.handles.add(ctx, ownerOf(ctx, rpcCtx), &openHandle{file: file, export: exp, entries: entries})
 	res := &pb.OpenDirRes{
-		Result: &pb.OpenedDir{},
+		Result: &pb.OpenedDir{
+			Handle: handle,
+		},
 	}
 	return res, nil
 }
 
-// TODO implement any locks here
 func (s *server) OpenFile(ctx context.Context, req *pb.OpenFileReq) (*pb.OpenFileRes, error) {
 	path := req.Name
 	rpcCtx := req.Context
-	logger.Print("received valid OpenFile request. ", path, rpcCtx)
+	// creation and truncation arrive as separate requests, and appends
+	// already carry their offset
+	flags := int(req.Flags) & (os.O_RDONLY | os.O_WRONLY | os.O_RDWR | os.O_SYNC | syscall.O_DSYNC)
+	logger.Print("received valid OpenFile request. ", path, redacted(rpcCtx), flags)
+	exp, err := s.resolveExport(rpcCtx, flags&syscall.O_ACCMODE != os.O_RDONLY)
+	if err != nil {
+		return nil, err
+	}
+	backend := exp.backend
+	// with writeback caching, the kernel reads in partially written pages
+	// through whichever handle it has, write-only ones included
+	openFlags := flags
+	if flags&os.O_WRONLY != 0 {
+		openFlags = flags&^os.O_WRONLY | os.O_RDWR
+	}
+	file, err := backend.Open(path, openFlags, 0)
+	if openFlags != flags && errors.Is(err, fs.ErrPermission) {
+		file, err = backend.Open(path, flags, 0)
+	}
+	if handleErr(err, "backend.Open failed") != nil {
+		return nil, toStatus(err)
+	}
+	handle := s.handles.add(ctx, ownerOf(ctx, rpcCtx), &openHandle{file: file, export: exp})
 	res := &pb.OpenFileRes{
-		Result: &pb.OpenedFile{},
+		Result: &pb.OpenedFile{
+			Handle:    handle,
+			OpenFlags: uint32(flags),
+		},
+	}
+	return res, nil
+}
+
+func (s *server) ReleaseHandle(ctx context.Context, req *pb.ReleaseHandleReq) (*pb.ReleaseHandleRes, error) {
+	handle := req.Handle
+	rpcCtx := req.Context
+	logger.Print("received valid ReleaseHandle request. ", handle, redacted(rpcCtx))
+	err := s.handles.release(handle, ownerOf(ctx, rpcCtx))
+	if handleErr(err, "handles.release failed") != nil {
+		return nil, toStatus(err)
+	}
+	res := &pb.ReleaseHandleRes{
+		Result: true,
 	}
 	return res, nil
 }
@@ -101,68 +315,453 @@ func (s *server) OpenFile(ctx context.Context, req *pb.OpenFileReq) (*pb.OpenFil
 func (s *server) ReadDir(ctx context.Context, req *pb.ReadDirReq) (*pb.ReadDirRes, error) {
 	path := req.Name
 	rpcCtx := req.Context
-	logger.Print("received valid ReadDir request. ", path, rpcCtx)
-	entries, err := os.ReadDir(path)
-	if handleErr(err, "os.ReadDir failed") != nil {
+	handle := req.Handle
+	logger.Print("received valid ReadDir request. ", path, redacted(rpcCtx), handle)
+	backend, err := s.exportFor(rpcCtx)
+	if err != nil {
+		return nil, err
+	}
+	var entries []*pb.DirEntry
+	if handle != 0 {
+		openHandle, err := s.handleFor(ctx, rpcCtx, handle, false)
+		if err != nil {
+			return nil, err
+		}
+		entries = openHandle.entries
+	} else {
+		dir, err := backend.Open(path, os.O_RDONLY, 0)
+		if handleErr(err, "backend.Open failed") != nil {
+			return nil, toStatus(err)
+		}
+		defer dir.Close()
+		entries, err = listDir(dir)
+		if err != nil {
+			return nil, toStatus(err)
+		}
+	}
+	res := &pb.ReadDirRes{
+		Result: entries,
+	}
+
+	return res, nil
+}
+
+// lists a directory along with the stats of its entries
+func listDir(dir File) ([]*pb.DirEntry, error) {
+	entries, err := dir.ReadDir()
+	if handleErr(err, "file.ReadDir failed") != nil {
 		return nil, err
 	}
 	resEntries := []*pb.DirEntry{}
 	for _, entry := range entries {
 		info, err := entry.Info()
-		if handleErr(err, "entry.Info() failed") != nil {
+		// entries removed while the directory was being read are skipped
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if handleErr(err, "entry.Info failed") != nil {
 			return nil, err
 		}
 		obj := pb.DirEntry{
 			Name:     entry.Name(),
 			IsDir:    entry.IsDir(),
 			FileMode: uint32(entry.Type()),
-			Info: &pb.FileInfo{
-				Name:    info.Name(),
-				Size:    info.Size(),
-				Mode:    uint32(info.Mode()),
-				ModTime: timestamppb.New(info.ModTime()),
-				IsDir:   info.IsDir(),
-				Ino:     info.Sys().(*syscall.Stat_t).Ino,
-			},
+			Info:     toFileInfo(info),
 		}
 		resEntries = append(resEntries, &obj)
 	}
-	res := &pb.ReadDirRes{
-		Result: resEntries,
-	}
-
-	return res, nil
+	return resEntries, nil
 }
 
 func (s *server) ReadFile(ctx context.Context, req *pb.ReadFileReq) (*pb.ReadFileRes, error) {
 	path := req.Name
 	rpcCtx := req.Context
-	logger.Print("received valid ReadFile request. ", path, rpcCtx)
-	file, err := os.ReadFile(path)
-	if handleErr(err, "os.Stat failed") != nil {
-		return nil, err
+	offset := req.Offset
+	size := req.Size
+	handle := req.Handle
+	logger.Print("received valid ReadFile request. ", path, redacted(rpcCtx), offset, size, handle)
+	// larger reads go through ReadFileStream
+	if size < 0 || size > maxChunkSize {
+		return nil, status.Errorf(codes.InvalidArgument, "read size %v is not within 0 and %v", size, maxChunkSize)
+	}
+	file, done, err := s.fileFor(ctx, rpcCtx, handle, path, os.O_RDONLY)
+	if handleErr(err, "opening file failed") != nil {
+		return nil, toStatus(err)
+	}
+	defer done()
+	dst := make([]byte, size)
+	n, err := file.ReadAt(dst, offset)
+	// a short read at the end of the file is not an error
+	if err != io.EOF && handleErr(err, "file.ReadAt failed") != nil {
+		return nil, toStatus(err)
 	}
-	// Only Dst is used
 	res := &pb.ReadFileRes{
 		Result: &pb.FileEntry{
-			Dst: file,
+			Handle:    handle,
+			Offset:    offset,
+			Size:      size,
+			Dst:       dst[:n],
+			BytesRead: int32(n),
 		},
 	}
 	return res, nil
 }
 
+func (s *server) ReadFileStream(req *pb.ReadFileReq, stream pb.FuseService_ReadFileStreamServer) error {
+	path := req.Name
+	rpcCtx := req.Context
+	offset := req.Offset
+	size := req.Size
+	handle := req.Handle
+	chunkSize := req.ChunkSize
+	logger.Print("received valid ReadFileStream request. ", path, redacted(rpcCtx), offset, size, handle, chunkSize)
+	if chunkSize <= 0 {
+		chunkSize = defaultChunkSize
+	}
+	chunkSize = min(chunkSize, maxChunkSize)
+	file, done, err := s.fileFor(stream.Context(), rpcCtx, handle, path, os.O_RDONLY)
+	if handleErr(err, "opening file failed") != nil {
+		return toStatus(err)
+	}
+	defer done()
+	for sent := int64(0); sent < size; {
+		// chunks are not reused, as a sent message may still be held by grpc
+		dst := make([]byte, min(chunkSize, size-sent))
+		n, err := file.ReadAt(dst, offset+sent)
+		if err != nil && err != io.EOF {
+			handleErr(err, "file.ReadAt failed")
+			return toStatus(err)
+		}
+		if n > 0 {
+			res := &pb.ReadFileRes{
+				Result: &pb.FileEntry{
+					Handle:    handle,
+					Offset:    offset + sent,
+					Size:      int64(len(dst)),
+					Dst:       dst[:n],
+					BytesRead: int32(n),
+				},
+			}
+			if err := stream.Send(res); handleErr(err, "stream.Send failed") != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		sent += int64(n)
+	}
+	return nil
+}
+
 func (s *server) WriteFile(ctx context.Context, req *pb.WriteFileReq) (*pb.WriteFileRes, error) {
 	path := req.Name
 	rpcCtx := req.Context
 	data := req.Data
 	offset := req.Offset
-	// TODO properly use offset
-	logger.Print("received valid WriteFile request. ", path, rpcCtx, offset)
-	err := os.WriteFile(path, data, 0666)
-	if handleErr(err, "os.WriteFile failed") != nil {
+	handle := req.Handle
+	logger.Print("received valid WriteFile request. ", path, redacted(rpcCtx), offset, len(data), handle)
+	if _, err := s.writableExportFor(rpcCtx); err != nil {
 		return nil, err
 	}
+	// write in place, without truncating the rest of the file
+	file, done, err := s.fileFor(ctx, rpcCtx, handle, path, os.O_WRONLY)
+	if handleErr(err, "opening file failed") != nil {
+		return nil, toStatus(err)
+	}
+	defer done()
+	n, err := file.WriteAt(data, offset)
+	if handleErr(err, "file.WriteAt failed") != nil {
+		return nil, toStatus(err)
+	}
 	res := &pb.WriteFileRes{
+		Result:       true,
+		BytesWritten: int64(n),
+	}
+	return res, nil
+}
+
+// applies a stream of writes in order, acknowledging each once committed
+func (s *server) WriteFileStream(stream pb.FuseService_WriteFileStreamServer) error {
+	logger.Print("received valid WriteFileStream request.")
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if handleErr(err, "stream.Recv failed") != nil {
+			return err
+		}
+		res, err := s.WriteFile(stream.Context(), req)
+		if err != nil {
+			return err
+		}
+		ack := &pb.WriteFileAck{
+			Offset:       req.Offset + res.BytesWritten,
+			BytesWritten: res.BytesWritten,
+		}
+		if err := stream.Send(ack); handleErr(err, "stream.Send failed") != nil {
+			return err
+		}
+	}
+}
+
+func (s *server) CreateFile(ctx context.Context, req *pb.CreateFileReq) (*pb.CreateFileRes, error) {
+	path := req.Name
+	rpcCtx := req.Context
+	mode := os.FileMode(req.Mode)
+	logger.Print("received valid CreateFile request. ", path, redacted(rpcCtx), mode)
+	exp, err := s.resolveExport(rpcCtx, true)
+	if err != nil {
+		return nil, err
+	}
+	file, err := exp.backend.Open(path, os.O_RDWR|os.O_CREATE|os.O_EXCL, mode.Perm())
+	if handleErr(err, "backend.Open failed") != nil {
+		return nil, toStatus(err)
+	}
+	fileInfo, err := file.Stat()
+	if handleErr(err, "file.Stat failed") != nil {
+		file.Close()
+		return nil, toStatus(err)
+	}
+	// the new file is left open, as creating it also opens it
+	handle := s.handles.add(ctx, ownerOf(ctx, rpcCtx), &openHandle{file: file, export: exp})
+	res := &pb.CreateFileRes{
+		Result: toFileInfo(fileInfo),
+		Handle: handle,
+	}
+	return res, nil
+}
+
+func (s *server) MkDir(ctx context.Context, req *pb.MkDirReq) (*pb.MkDirRes, error) {
+	path := req.Name
+	rpcCtx := req.Context
+	mode := os.FileMode(req.Mode)
+	logger.Print("received valid MkDir request. ", path, redacted(rpcCtx), mode)
+	backend, err := s.writableExportFor(rpcCtx)
+	if err != nil {
+		return nil, err
+	}
+	err = backend.Mkdir(path, mode.Perm())
+	if handleErr(err, "backend.Mkdir failed") != nil {
+		return nil, toStatus(err)
+	}
+	fileInfo, err := backend.Lstat(path)
+	if handleErr(err, "backend.Lstat failed") != nil {
+		return nil, toStatus(err)
+	}
+	res := &pb.MkDirRes{
+		Result: toFileInfo(fileInfo),
+	}
+	return res, nil
+}
+
+func (s *server) RmDir(ctx context.Context, req *pb.RmDirReq) (*pb.RmDirRes, error) {
+	path := req.Name
+	rpcCtx := req.Context
+	logger.Print("received valid RmDir request. ", path, redacted(rpcCtx))
+	backend, err := s.writableExportFor(rpcCtx)
+	if err != nil {
+		return nil, err
+	}
+	err = backend.Rmdir(path)
+	if handleErr(err, "backend.Rmdir failed") != nil {
+		return nil, toStatus(err)
+	}
+	res := &pb.RmDirRes{
+		Result: true,
+	}
+	return res, nil
+}
+
+func (s *server) Unlink(ctx context.Context, req *pb.UnlinkReq) (*pb.UnlinkRes, error) {
+	path := req.Name
+	rpcCtx := req.Context
+	logger.Print("received valid Unlink request. ", path, redacted(rpcCtx))
+	backend, err := s.writableExportFor(rpcCtx)
+	if err != nil {
+		return nil, err
+	}
+	// stated first, to tell whether the file lives on through other links
+	fileInfo, err := backend.Lstat(path)
+	if handleErr(err, "backend.Lstat failed") != nil {
+		return nil, toStatus(err)
+	}
+	err = backend.Unlink(path)
+	if handleErr(err, "backend.Unlink failed") != nil {
+		return nil, toStatus(err)
+	}
+	res := &pb.UnlinkRes{
+		Result: true,
+		Nlink:  max(fileInfo.Nlink(), 1) - 1,
+	}
+	return res, nil
+}
+
+func (s *server) Rename(ctx context.Context, req *pb.RenameReq) (*pb.RenameRes, error) {
+	path := req.Name
+	rpcCtx := req.Context
+	newPath := req.NewName
+	logger.Print("received valid Rename request. ", path, redacted(rpcCtx), newPath)
+	backend, err := s.writableExportFor(rpcCtx)
+	if err != nil {
+		return nil, err
+	}
+	// whatever is replaced at the new name may live on through other links
+	var nlink uint64
+	if replaced, err := backend.Lstat(newPath); err == nil && !replaced.IsDir() {
+		nlink = replaced.Nlink() - 1
+		if old, err := backend.Lstat(path); err == nil && old.Dev() == replaced.Dev() && old.Ino() == replaced.Ino() {
+			// renaming a link onto another of the same file changes nothing
+			nlink = replaced.Nlink()
+		}
+	}
+	err = backend.Rename(path, newPath)
+	if handleErr(err, "backend.Rename failed") != nil {
+		return nil, toStatus(err)
+	}
+	res := &pb.RenameRes{
+		Result: true,
+		Nlink:  nlink,
+	}
+	return res, nil
+}
+
+func (s *server) ReadLink(ctx context.Context, req *pb.ReadLinkReq) (*pb.ReadLinkRes, error) {
+	path := req.Name
+	rpcCtx := req.Context
+	logger.Print("received valid ReadLink request. ", path, redacted(rpcCtx))
+	backend, err := s.exportFor(rpcCtx)
+	if err != nil {
+		return nil, err
+	}
+	target, err := backend.Readlink(path)
+	if handleErr(err, "backend.Readlink failed") != nil {
+		return nil, toStatus(err)
+	}
+	res := &pb.ReadLinkRes{
+		Result: target,
+	}
+	return res, nil
+}
+
+func (s *server) Symlink(ctx context.Context, req *pb.SymlinkReq) (*pb.SymlinkRes, error) {
+	path := req.Name
+	rpcCtx := req.Context
+	target := req.Target
+	logger.Print("received valid Symlink request. ", path, redacted(rpcCtx), target)
+	backend, err := s.writableExportFor(rpcCtx)
+	if err != nil {
+		return nil, err
+	}
+	err = backend.Symlink(target, path)
+	if handleErr(err, "backend.Symlink failed") != nil {
+		return nil, toStatus(err)
+	}
+	fileInfo, err := backend.Lstat(path)
+	if handleErr(err, "backend.Lstat failed") != nil {
+		return nil, toStatus(err)
+	}
+	res := &pb.SymlinkRes{
+		Result: toFileInfo(fileInfo),
+	}
+	return res, nil
+}
+
+func (s *server) Link(ctx context.Context, req *pb.LinkReq) (*pb.LinkRes, error) {
+	path := req.Name
+	rpcCtx := req.Context
+	target := req.Target
+	logger.Print("received valid Link request. ", path, redacted(rpcCtx), target)
+	backend, err := s.writableExportFor(rpcCtx)
+	if err != nil {
+		return nil, err
+	}
+	err = backend.Link(target, path)
+	if handleErr(err, "backend.Link failed") != nil {
+		return nil, toStatus(err)
+	}
+	fileInfo, err := backend.Lstat(path)
+	if handleErr(err, "backend.Lstat failed") != nil {
+		return nil, toStatus(err)
+	}
+	res := &pb.LinkRes{
+		Result: toFileInfo(fileInfo),
+	}
+	return res, nil
+}
+
+func (s *server) GetXattr(ctx context.Context, req *pb.GetXattrReq) (*pb.GetXattrRes, error) {
+	path := req.Name
+	rpcCtx := req.Context
+	attr := req.Attr
+	logger.Print("received valid GetXattr request. ", path, redacted(rpcCtx), attr)
+	backend, err := s.exportFor(rpcCtx)
+	if err != nil {
+		return nil, err
+	}
+	value, err := backend.GetXattr(path, attr)
+	if handleErr(err, "backend.GetXattr failed") != nil {
+		return nil, toStatus(err)
+	}
+	res := &pb.GetXattrRes{
+		Result: value,
+	}
+	return res, nil
+}
+
+func (s *server) ListXattr(ctx context.Context, req *pb.ListXattrReq) (*pb.ListXattrRes, error) {
+	path := req.Name
+	rpcCtx := req.Context
+	logger.Print("received valid ListXattr request. ", path, redacted(rpcCtx))
+	backend, err := s.exportFor(rpcCtx)
+	if err != nil {
+		return nil, err
+	}
+	attrs, err := backend.ListXattr(path)
+	if handleErr(err, "backend.ListXattr failed") != nil {
+		return nil, toStatus(err)
+	}
+	res := &pb.ListXattrRes{
+		Result: attrs,
+	}
+	return res, nil
+}
+
+func (s *server) SetXattr(ctx context.Context, req *pb.SetXattrReq) (*pb.SetXattrRes, error) {
+	path := req.Name
+	rpcCtx := req.Context
+	attr := req.Attr
+	flags := req.Flags
+	logger.Print("received valid SetXattr request. ", path, redacted(rpcCtx), attr, flags)
+	backend, err := s.writableExportFor(rpcCtx)
+	if err != nil {
+		return nil, err
+	}
+	err = backend.SetXattr(path, attr, req.Value, int(flags))
+	if handleErr(err, "backend.SetXattr failed") != nil {
+		return nil, toStatus(err)
+	}
+	res := &pb.SetXattrRes{
+		Result: true,
+	}
+	return res, nil
+}
+
+func (s *server) RemoveXattr(ctx context.Context, req *pb.RemoveXattrReq) (*pb.RemoveXattrRes, error) {
+	path := req.Name
+	rpcCtx := req.Context
+	attr := req.Attr
+	logger.Print("received valid RemoveXattr request. ", path, redacted(rpcCtx), attr)
+	backend, err := s.writableExportFor(rpcCtx)
+	if err != nil {
+		return nil, err
+	}
+	err = backend.RemoveXattr(path, attr)
+	if handleErr(err, "backend.RemoveXattr failed") != nil {
+		return nil, toStatus(err)
+	}
+	res := &pb.RemoveXattrRes{
 		Result: true,
 	}
 	return res, nil
@@ -171,25 +770,65 @@ func (s *server) WriteFile(ctx context.Context, req *pb.WriteFileReq) (*pb.Write
 func (s *server) SetInodeAtt(ctx context.Context, req *pb.SetInodeAttReq) (*pb.SetInodeAttRes, error) {
 	path := req.Name
 	rpcCtx := req.Context
+	handle := req.Handle
 	// updated values
 	size := req.Size
 	mode := req.FileMode
 	atime := req.ATime
 	mtime := req.MTime
-	logger.Print("received valid SetInodeAtt request. ", path, rpcCtx, size, mode, atime, mtime)
+	logger.Print("received valid SetInodeAtt request. ", path, redacted(rpcCtx), size, mode, atime, mtime, handle)
+	backend, err := s.writableExportFor(rpcCtx)
+	if err != nil {
+		return nil, err
+	}
+	var file File
+	if handle != 0 {
+		// attributes of files unlinked while open can only be set through
+		// their handle
+		openHandle, err := s.handleFor(ctx, rpcCtx, handle, true)
+		if err != nil {
+			return nil, err
+		}
+		file = openHandle.file
+	} else {
+		// resolved once, so that the updates below all apply to the same
+		// file
+		file, err = backend.OpenAttrs(path)
+		if handleErr(err, "backend.OpenAttrs failed") != nil {
+			return nil, toStatus(err)
+		}
+		defer file.Close()
+	}
 	if size != nil {
-		os.Truncate(path, int64(*size))
+		err := file.Truncate(int64(*size))
+		if handleErr(err, "file.Truncate failed") != nil {
+			return nil, toStatus(err)
+		}
 	}
 	if mode != nil {
-		os.Chmod(path, os.FileMode(*mode))
+		err := file.Chmod(os.FileMode(*mode))
+		if handleErr(err, "file.Chmod failed") != nil {
+			return nil, toStatus(err)
+		}
 	}
-	if (atime != nil) && (mtime != nil) {
-		os.Chtimes(path, atime.AsTime(), mtime.AsTime())
+	if (atime != nil) || (mtime != nil) {
+		// zero times are left unchanged
+		var at, mt time.Time
+		if atime != nil {
+			at = atime.AsTime()
+		}
+		if mtime != nil {
+			mt = mtime.AsTime()
+		}
+		err := file.Chtimes(at, mt)
+		if handleErr(err, "file.Chtimes failed") != nil {
+			return nil, toStatus(err)
+		}
 	}
 	// once updated, get and return latest values
-	fileInfo, err := os.Stat(path)
-	if handleErr(err, "os.Stat failed") != nil {
-		return nil, err
+	fileInfo, err := file.Stat()
+	if handleErr(err, "file.Stat failed") != nil {
+		return nil, toStatus(err)
 	}
 	res := &pb.SetInodeAttRes{
 		Result: &pb.InodeAtt{
@@ -202,21 +841,133 @@ func (s *server) SetInodeAtt(ctx context.Context, req *pb.SetInodeAttReq) (*pb.S
 	return res, nil
 }
 
+// listens on a tcp host:port, or on a unix socket given as unix:///path
+func listen(address string) (net.Listener, error) {
+	path, isUnix := strings.CutPrefix(address, "unix://")
+	if !isUnix {
+		return net.Listen("tcp", address)
+	}
+	// a socket left behind by a previous run would fail the listen
+	if info, err := os.Lstat(path); err == nil && info.Mode()&fs.ModeSocket != 0 {
+		os.Remove(path)
+	}
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	// anyone may connect, clients being authorized by their peer credentials
+	if err := os.Chmod(path, 0666); err != nil {
+		listener.Close()
+		return nil, err
+	}
+	return listener, nil
+}
+
 func main() {
 
-	listener, err := net.Listen("tcp", "127.0.0.1:50000")
+	exports := exportsFlag{}
+	var handleTimeout time.Duration
+	var reportInterval time.Duration
+	var listenAddress string
+	var allowUids string
+	var allowGids string
+	var tlsCert string
+	var tlsKey string
+	var tlsCA string
+	var requireClientCert bool
+	var tokenFile string
+	var jwtSecretFile string
+
+	flag.Var(exports, "export", "Directory to serve, which clients cannot reach out of, as name=path[,ro][,gateways=gw1:gw2], or mem:[capacity] as the path for a scratch tree held in memory (repeatable)")
+	flag.DurationVar(&handleTimeout, "handle-timeout", time.Hour, "Close handles idle for longer than this (0 to keep them open)")
+	flag.DurationVar(&reportInterval, "report-interval", 10*time.Minute, "Log how many handles are open this often (0 to never)")
+	flag.StringVar(&listenAddress, "listen", "127.0.0.1:50000", "Address to listen on, as host:port or unix:///path/to/socket")
+	flag.StringVar(&allowUids, "allow-uids", "", "Comma separated uids allowed to connect over a unix socket (defaults to the server's own)")
+	flag.StringVar(&allowGids, "allow-gids", "", "Comma separated gids allowed to connect over a unix socket")
+	flag.StringVar(&tlsCert, "tls-cert", "", "Certificate to serve TLS with, over tcp only")
+	flag.StringVar(&tlsKey, "tls-key", "", "Private key of the TLS certificate")
+	flag.StringVar(&tlsCA, "tls-ca", "", "CA bundle to verify client certificates against")
+	flag.BoolVar(&requireClientCert, "require-client-cert", false, "Reject TLS clients without a certificate signed by the CA bundle")
+	flag.StringVar(&tokenFile, "token-file", "", "File of the access tokens accepted, one per line, optionally followed by the agent each is issued to, or - for any, and the gateway")
+	flag.StringVar(&jwtSecretFile, "jwt-secret-file", "", "File of the secret accepted JWTs are HMAC-SHA256 signed with")
+	flag.Parse()
+
+	var validator tokenValidator
+	var err error
+	switch {
+	case tokenFile != "" && jwtSecretFile != "":
+		logger.Fatal("Please specify at most one of token file and JWT secret file")
+	case tokenFile != "":
+		validator, err = newStaticTokens(tokenFile)
+	case jwtSecretFile != "":
+		validator, err = newHMACJWT(jwtSecretFile)
+	}
+	if handleErr(err, "Invalid token configuration") != nil {
+		os.Exit(1)
+	}
+
+	if len(exports) == 0 {
+		logger.Fatal("Please specify at least one directory to export")
+	}
+	// the gateway a request names is only trusted when its token says so
+	for _, exp := range exports {
+		if len(exp.gateways) > 0 && (validator == nil || !validator.verifiesGateways()) {
+			logger.Fatalf("Export '%v' is limited to gateways, which requires tokens issued to gateways, from -jwt-secret-file or a gateway column in -token-file", exp.name)
+		}
+	}
+
+	// unix sockets are authorized by peer credentials, which TLS would replace
+	if tlsCert != "" && strings.HasPrefix(listenAddress, "unix://") {
+		logger.Fatal("Please serve TLS over tcp, unix sockets being authorized by -allow-uids and -allow-gids")
+	}
+
+	listener, err := listen(listenAddress)
 	if handleErr(err, "Could not start GRPC server") != nil {
 		os.Exit(1)
 	}
 
-	s := grpc.NewServer()
-	pb.RegisterFuseServiceServer(s, &server{})
+	handles := newHandleTable()
+	if handleTimeout > 0 {
+		go handles.expire(handleTimeout)
+	}
+	if reportInterval > 0 {
+		go handles.report(reportInterval)
+	}
+
+	opts := []grpc.ServerOption{grpc.StatsHandler(&connTracker{handles: handles})}
+	if tlsCert != "" {
+		config, err := newTLSConfig(tlsCert, tlsKey, tlsCA, requireClientCert)
+		if handleErr(err, "Invalid TLS configuration") != nil {
+			os.Exit(1)
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(config)))
+	} else if strings.HasPrefix(listenAddress, "unix://") {
+		// clients on the same host are known by the uid and gid of their process
+		policy, err := newPeerPolicy(allowUids, allowGids)
+		if handleErr(err, "Invalid peer policy") != nil {
+			os.Exit(1)
+		}
+		opts = append(opts,
+			grpc.Creds(peerCredentials{}),
+			grpc.ChainUnaryInterceptor(policy.unaryInterceptor),
+			grpc.ChainStreamInterceptor(policy.streamInterceptor))
+	}
+	if validator != nil {
+		auth := &authenticator{validator: validator}
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(auth.unaryInterceptor),
+			grpc.ChainStreamInterceptor(auth.streamInterceptor))
+	}
+	s := grpc.NewServer(opts...)
+	pb.RegisterFuseServiceServer(s, &server{handles: handles, exports: exports})
 
 	go s.Serve(listener)
-	logState("running until interrupt")
+	logState("running until interrupt", listener.Addr())
 
 	sigCh := make(chan os.Signal, 1)
 	signal.Notify(sigCh, os.Interrupt)
 	<-sigCh
 	logState("interrupt received, terminating.")
+	// closes the listener, removing its socket if any
+	s.Stop()
 }
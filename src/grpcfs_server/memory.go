@@ -0,0 +1,805 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	defaultMemCapacity = 1 << 30
+	memBlockSize       = 4096
+	// charged for each node, standing for its own bookkeeping
+	memNodeSize = 256
+	// the most symlinks followed in a single lookup, as on linux
+	maxSymlinkHops = 40
+	maxXattrSize   = 64 << 10
+)
+
+// device numbers of memory backends, told apart from each other so that
+// clients never confuse their inodes
+var memDevices atomic.Uint64
+
+// a backend holding its whole tree in memory, which is lost when the server
+// stops. Its capacity bounds the memory allocated to file data, symlink
+// targets and xattrs, along with a fixed charge per node.
+type memBackend struct {
+	mu       sync.Mutex
+	root     *memNode
+	dev      uint64
+	nextIno  uint64
+	capacity int64
+	used     int64
+	nodes    uint64
+}
+
+// a file, directory or symlink of a memory backend
+type memNode struct {
+	ino   uint64
+	mode  os.FileMode
+	nlink uint64
+	// files opened on the node, which keep it alive once unlinked
+	opens  int
+	atime  time.Time
+	mtime  time.Time
+	ctime  time.Time
+	xattrs map[string][]byte
+	data   []byte
+	target string
+	// entries of a directory, and the directory holding it
+	children map[string]*memNode
+	parent   *memNode
+}
+
+func newMemBackend(capacity int64) *memBackend {
+	b := &memBackend{
+		dev:      memDevices.Add(1),
+		capacity: capacity,
+	}
+	// capacities are never smaller than a block, leaving room for the root
+	b.root, _ = b.newNode(os.ModeDir|0755, "")
+	b.root.parent = b.root
+	return b
+}
+
+// parses the capacity of a memory backend, given in bytes with an optional
+// K, M, G or T suffix, defaulting when empty. It is at least a block.
+func parseCapacity(capacity string) (int64, error) {
+	if capacity == "" {
+		return defaultMemCapacity, nil
+	}
+	size := capacity
+	shift := 0
+	switch strings.ToUpper(size[len(size)-1:]) {
+	case "K":
+		shift = 10
+	case "M":
+		shift = 20
+	case "G":
+		shift = 30
+	case "T":
+		shift = 40
+	}
+	if shift != 0 {
+		size = size[:len(size)-1]
+	}
+	n, err := strconv.ParseInt(size, 10, 64)
+	if err != nil || n <= 0 || n > (1<<62)>>shift || n<<shift < memBlockSize {
+		return 0, fmt.Errorf("invalid capacity '%v'", capacity)
+	}
+	return n << shift, nil
+}
+
+// accounts for n more bytes of memory, failing with ENOSPC past the capacity
+func (b *memBackend) charge(n int64) error {
+	if n > 0 && b.used+n > b.capacity {
+		return unix.ENOSPC
+	}
+	b.used += n
+	return nil
+}
+
+func (b *memBackend) newNode(mode os.FileMode, target string) (*memNode, error) {
+	if err := b.charge(memNodeSize + int64(len(target))); err != nil {
+		return nil, err
+	}
+	b.nextIno++
+	b.nodes++
+	now := time.Now()
+	node := &memNode{
+		ino:    b.nextIno,
+		mode:   mode,
+		nlink:  1,
+		atime:  now,
+		mtime:  now,
+		ctime:  now,
+		xattrs: map[string][]byte{},
+		target: target,
+	}
+	if mode.IsDir() {
+		node.children = map[string]*memNode{}
+	}
+	return node, nil
+}
+
+// the memory charged for an xattr
+func xattrSize(attr string, value []byte) int64 {
+	return int64(len(attr) + len(value))
+}
+
+// drops a node once it is neither linked nor open, returning its space
+func (b *memBackend) release(node *memNode) {
+	if node.nlink == 0 && node.opens == 0 {
+		b.used -= memNodeSize + int64(cap(node.data)+len(node.target))
+		for attr, value := range node.xattrs {
+			b.used -= xattrSize(attr, value)
+		}
+		b.nodes--
+		node.data = nil
+		node.xattrs = nil
+	}
+}
+
+// resizes the data of a file, charging the buffer it is held in against the
+// capacity of the backend
+func (b *memBackend) resize(node *memNode, size int64) error {
+	allocated := int64(cap(node.data))
+	switch {
+	case size == 0:
+		b.used -= allocated
+		node.data = nil
+	case size <= allocated:
+		// cut bytes are zeroed, so that growing the file again reads them
+		// back as zeros
+		if size < int64(len(node.data)) {
+			clear(node.data[size:])
+		}
+		node.data = node.data[:size]
+	default:
+		// buffers grow geometrically, as far as the capacity allows
+		free := b.capacity - b.used + allocated
+		if size > free {
+			return unix.ENOSPC
+		}
+		data := make([]byte, size, min(max(size, 2*allocated), free))
+		copy(data, node.data)
+		node.data = data
+		b.used += int64(cap(data)) - allocated
+	}
+	return nil
+}
+
+func memErr(op string, path string, err error) error {
+	return &os.PathError{Op: op, Path: path, Err: err}
+}
+
+// walks to the node a path names, following symlinks on the way and, when
+// follow is set, at its end. The root of the backend is its own parent, so
+// that neither .. nor absolute symlinks lead out of it.
+func (b *memBackend) lookup(path string, follow bool) (*memNode, error) {
+	node := b.root
+	names := strings.Split(relative(path), "/")
+	hops := 0
+	for len(names) > 0 {
+		name := names[0]
+		names = names[1:]
+		if name == "." || name == "" {
+			continue
+		}
+		if !node.mode.IsDir() {
+			return nil, unix.ENOTDIR
+		}
+		if name == ".." {
+			node = node.parent
+			continue
+		}
+		child, found := node.children[name]
+		if !found {
+			return nil, unix.ENOENT
+		}
+		if child.mode&os.ModeSymlink != 0 && (len(names) > 0 || follow) {
+			hops++
+			if hops > maxSymlinkHops {
+				return nil, unix.ELOOP
+			}
+			if strings.HasPrefix(child.target, "/") {
+				node = b.root
+			}
+			names = append(strings.Split(child.target, "/"), names...)
+			continue
+		}
+		node = child
+	}
+	return node, nil
+}
+
+// walks to the directory holding the last component of a path, which must
+// not be the root
+func (b *memBackend) lookupParent(path string) (*memNode, string, error) {
+	rel := relative(path)
+	if rel == "." {
+		return nil, "", unix.EBUSY
+	}
+	dir, err := b.lookup(filepath.Dir(rel), true)
+	if err != nil {
+		return nil, "", err
+	}
+	if !dir.mode.IsDir() {
+		return nil, "", unix.ENOTDIR
+	}
+	return dir, filepath.Base(rel), nil
+}
+
+// links a new node into a directory
+func (b *memBackend) link(dir *memNode, name string, node *memNode) {
+	dir.children[name] = node
+	if node.mode.IsDir() {
+		node.parent = dir
+	}
+	dir.mtime = time.Now()
+	dir.ctime = dir.mtime
+}
+
+// unlinks an entry from a directory, dropping its node when nothing else
+// refers to it
+func (b *memBackend) unlink(dir *memNode, name string) {
+	node := dir.children[name]
+	delete(dir.children, name)
+	node.nlink--
+	node.ctime = time.Now()
+	dir.mtime = node.ctime
+	dir.ctime = node.ctime
+	b.release(node)
+}
+
+func (b *memBackend) Open(path string, flag int, perm os.FileMode) (File, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var node *memNode
+	var err error
+	if flag&os.O_CREATE != 0 {
+		var dir *memNode
+		var name string
+		dir, name, err = b.lookupParent(path)
+		if err != nil {
+			return nil, memErr("open", path, err)
+		}
+		if _, found := dir.children[name]; !found {
+			node, err = b.newNode(perm.Perm(), "")
+			if err != nil {
+				return nil, memErr("open", path, err)
+			}
+			b.link(dir, name, node)
+		} else if flag&os.O_EXCL != 0 {
+			return nil, memErr("open", path, unix.EEXIST)
+		}
+	}
+	if node == nil {
+		node, err = b.lookup(path, flag&unix.O_NOFOLLOW == 0)
+		if err != nil {
+			return nil, memErr("open", path, err)
+		}
+	}
+	if node.mode&os.ModeSymlink != 0 {
+		return nil, memErr("open", path, unix.ELOOP)
+	}
+	access := flag & syscall.O_ACCMODE
+	if node.mode.IsDir() && access != os.O_RDONLY {
+		return nil, memErr("open", path, unix.EISDIR)
+	}
+	if flag&unix.O_DIRECTORY != 0 && !node.mode.IsDir() {
+		return nil, memErr("open", path, unix.ENOTDIR)
+	}
+	if flag&os.O_TRUNC != 0 && access != os.O_RDONLY {
+		b.resize(node, 0)
+	}
+	node.opens++
+	file := &memFile{
+		backend:  b,
+		node:     node,
+		name:     filepath.Base("/" + relative(path)),
+		readable: access != os.O_WRONLY,
+		writable: access != os.O_RDONLY,
+	}
+	return file, nil
+}
+
+func (b *memBackend) OpenAttrs(path string) (File, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	node, err := b.lookup(path, true)
+	if err != nil {
+		return nil, memErr("open", path, err)
+	}
+	node.opens++
+	return &memFile{backend: b, node: node, name: filepath.Base("/" + relative(path))}, nil
+}
+
+func (b *memBackend) Lstat(path string) (FileInfo, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	node, err := b.lookup(path, false)
+	if err != nil {
+		return nil, memErr("lstat", path, err)
+	}
+	return b.stat(node, filepath.Base("/"+relative(path))), nil
+}
+
+// reports the capacity of the backend, and how many more nodes it has room for
+func (b *memBackend) StatFs() (*FsStats, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	free := uint64(b.capacity - b.used)
+	return &FsStats{
+		BlockSize:       memBlockSize,
+		Blocks:          uint64(b.capacity / memBlockSize),
+		BlocksFree:      free / memBlockSize,
+		BlocksAvailable: free / memBlockSize,
+		Inodes:          b.nodes + free/memNodeSize,
+		InodesFree:      free / memNodeSize,
+	}, nil
+}
+
+func (b *memBackend) Mkdir(path string, perm os.FileMode) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	dir, name, err := b.lookupParent(path)
+	if err == unix.EBUSY {
+		err = unix.EEXIST
+	}
+	if err != nil {
+		return memErr("mkdir", path, err)
+	}
+	if _, found := dir.children[name]; found {
+		return memErr("mkdir", path, unix.EEXIST)
+	}
+	node, err := b.newNode(os.ModeDir|perm.Perm(), "")
+	if err != nil {
+		return memErr("mkdir", path, err)
+	}
+	b.link(dir, name, node)
+	return nil
+}
+
+func (b *memBackend) Rmdir(path string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	dir, name, err := b.lookupParent(path)
+	if err != nil {
+		return memErr("rmdir", path, err)
+	}
+	node, found := dir.children[name]
+	switch {
+	case !found:
+		err = unix.ENOENT
+	case !node.mode.IsDir():
+		err = unix.ENOTDIR
+	case len(node.children) > 0:
+		err = unix.ENOTEMPTY
+	}
+	if err != nil {
+		return memErr("rmdir", path, err)
+	}
+	b.unlink(dir, name)
+	return nil
+}
+
+func (b *memBackend) Unlink(path string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	dir, name, err := b.lookupParent(path)
+	if err == unix.EBUSY {
+		err = unix.EISDIR
+	}
+	if err != nil {
+		return memErr("unlink", path, err)
+	}
+	node, found := dir.children[name]
+	if !found {
+		return memErr("unlink", path, unix.ENOENT)
+	}
+	if node.mode.IsDir() {
+		return memErr("unlink", path, unix.EISDIR)
+	}
+	b.unlink(dir, name)
+	return nil
+}
+
+// replaces whatever newPath names, as rename(2) does
+func (b *memBackend) Rename(oldPath string, newPath string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	oldDir, oldName, err := b.lookupParent(oldPath)
+	if err != nil {
+		return memErr("rename", oldPath, err)
+	}
+	node, found := oldDir.children[oldName]
+	if !found {
+		return memErr("rename", oldPath, unix.ENOENT)
+	}
+	newDir, newName, err := b.lookupParent(newPath)
+	if err != nil {
+		return memErr("rename", newPath, err)
+	}
+	if node.mode.IsDir() {
+		// a directory cannot be moved beneath itself
+		for dir := newDir; dir != b.root; dir = dir.parent {
+			if dir == node {
+				return memErr("rename", newPath, unix.EINVAL)
+			}
+		}
+	}
+	if existing, found := newDir.children[newName]; found {
+		if existing == node {
+			return nil
+		}
+		switch {
+		case node.mode.IsDir() && !existing.mode.IsDir():
+			err = unix.ENOTDIR
+		case !node.mode.IsDir() && existing.mode.IsDir():
+			err = unix.EISDIR
+		case len(existing.children) > 0:
+			err = unix.ENOTEMPTY
+		}
+		if err != nil {
+			return memErr("rename", newPath, err)
+		}
+		b.unlink(newDir, newName)
+	}
+	delete(oldDir.children, oldName)
+	oldDir.mtime = time.Now()
+	oldDir.ctime = oldDir.mtime
+	node.ctime = oldDir.mtime
+	b.link(newDir, newName, node)
+	return nil
+}
+
+func (b *memBackend) Readlink(path string) (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	node, err := b.lookup(path, false)
+	if err == nil && node.mode&os.ModeSymlink == 0 {
+		err = unix.EINVAL
+	}
+	if err != nil {
+		return "", memErr("readlink", path, err)
+	}
+	return node.target, nil
+}
+
+// the target is stored as is, and only ever followed within the backend
+func (b *memBackend) Symlink(target string, path string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	dir, name, err := b.lookupParent(path)
+	if err == unix.EBUSY {
+		err = unix.EEXIST
+	}
+	if err != nil {
+		return memErr("symlink", path, err)
+	}
+	if _, found := dir.children[name]; found {
+		return memErr("symlink", path, unix.EEXIST)
+	}
+	node, err := b.newNode(os.ModeSymlink|0777, target)
+	if err != nil {
+		return memErr("symlink", path, err)
+	}
+	b.link(dir, name, node)
+	return nil
+}
+
+func (b *memBackend) Link(oldPath string, newPath string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	node, err := b.lookup(oldPath, false)
+	if err != nil {
+		return memErr("link", oldPath, err)
+	}
+	if node.mode.IsDir() {
+		return memErr("link", oldPath, unix.EPERM)
+	}
+	dir, name, err := b.lookupParent(newPath)
+	if err == unix.EBUSY {
+		err = unix.EEXIST
+	}
+	if err != nil {
+		return memErr("link", newPath, err)
+	}
+	if _, found := dir.children[name]; found {
+		return memErr("link", newPath, unix.EEXIST)
+	}
+	node.nlink++
+	node.ctime = time.Now()
+	b.link(dir, name, node)
+	return nil
+}
+
+func (b *memBackend) GetXattr(path string, attr string) ([]byte, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	node, err := b.lookup(path, false)
+	if err != nil {
+		return nil, memErr("lgetxattr", path, err)
+	}
+	value, found := node.xattrs[attr]
+	if !found {
+		return nil, memErr("lgetxattr", path, unix.ENODATA)
+	}
+	return append([]byte{}, value...), nil
+}
+
+func (b *memBackend) ListXattr(path string) ([]string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	node, err := b.lookup(path, false)
+	if err != nil {
+		return nil, memErr("llistxattr", path, err)
+	}
+	attrs := []string{}
+	for attr := range node.xattrs {
+		attrs = append(attrs, attr)
+	}
+	sort.Strings(attrs)
+	return attrs, nil
+}
+
+func (b *memBackend) SetXattr(path string, attr string, value []byte, flags int) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	node, err := b.lookup(path, false)
+	if err != nil {
+		return memErr("lsetxattr", path, err)
+	}
+	old, found := node.xattrs[attr]
+	switch {
+	case attr == "":
+		err = unix.EINVAL
+	case len(value) > maxXattrSize:
+		err = unix.E2BIG
+	case found && flags&unix.XATTR_CREATE != 0:
+		err = unix.EEXIST
+	case !found && flags&unix.XATTR_REPLACE != 0:
+		err = unix.ENODATA
+	}
+	if err == nil {
+		charged := xattrSize(attr, value)
+		if found {
+			charged -= xattrSize(attr, old)
+		}
+		err = b.charge(charged)
+	}
+	if err != nil {
+		return memErr("lsetxattr", path, err)
+	}
+	node.xattrs[attr] = append([]byte{}, value...)
+	node.ctime = time.Now()
+	return nil
+}
+
+func (b *memBackend) RemoveXattr(path string, attr string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	node, err := b.lookup(path, false)
+	if err != nil {
+		return memErr("lremovexattr", path, err)
+	}
+	value, found := node.xattrs[attr]
+	if !found {
+		return memErr("lremovexattr", path, unix.ENODATA)
+	}
+	b.used -= xattrSize(attr, value)
+	delete(node.xattrs, attr)
+	node.ctime = time.Now()
+	return nil
+}
+
+// a snapshot of the attributes of a node
+func (b *memBackend) stat(node *memNode, name string) FileInfo {
+	size := int64(len(node.data))
+	nlink := node.nlink
+	if node.mode&os.ModeSymlink != 0 {
+		size = int64(len(node.target))
+	}
+	if node.mode.IsDir() {
+		// its entry in its parent, its own . and the .. of its subdirectories
+		nlink = 2
+		for _, child := range node.children {
+			if child.mode.IsDir() {
+				nlink++
+			}
+		}
+	}
+	return &memFileInfo{
+		name:  name,
+		size:  size,
+		mode:  node.mode,
+		mtime: node.mtime,
+		dev:   b.dev,
+		ino:   node.ino,
+		nlink: nlink,
+	}
+}
+
+type memFileInfo struct {
+	name  string
+	size  int64
+	mode  os.FileMode
+	mtime time.Time
+	dev   uint64
+	ino   uint64
+	nlink uint64
+}
+
+func (i *memFileInfo) Name() string       { return i.name }
+func (i *memFileInfo) Size() int64        { return i.size }
+func (i *memFileInfo) Mode() os.FileMode  { return i.mode }
+func (i *memFileInfo) ModTime() time.Time { return i.mtime }
+func (i *memFileInfo) IsDir() bool        { return i.mode.IsDir() }
+func (i *memFileInfo) Sys() any           { return nil }
+func (i *memFileInfo) Dev() uint64        { return i.dev }
+func (i *memFileInfo) Ino() uint64        { return i.ino }
+func (i *memFileInfo) Nlink() uint64      { return i.nlink }
+
+// a node opened from a memory backend
+type memFile struct {
+	backend  *memBackend
+	node     *memNode
+	name     string
+	readable bool
+	writable bool
+	closed   bool
+}
+
+func (f *memFile) ReadAt(dst []byte, offset int64) (int, error) {
+	f.backend.mu.Lock()
+	defer f.backend.mu.Unlock()
+	switch {
+	case !f.readable:
+		return 0, memErr("read", f.name, unix.EBADF)
+	case f.node.mode.IsDir():
+		return 0, memErr("read", f.name, unix.EISDIR)
+	case offset < 0:
+		return 0, memErr("read", f.name, unix.EINVAL)
+	}
+	if offset >= int64(len(f.node.data)) {
+		return 0, io.EOF
+	}
+	n := copy(dst, f.node.data[offset:])
+	if n < len(dst) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+func (f *memFile) WriteAt(data []byte, offset int64) (int, error) {
+	f.backend.mu.Lock()
+	defer f.backend.mu.Unlock()
+	switch {
+	case !f.writable:
+		return 0, memErr("write", f.name, unix.EBADF)
+	case offset < 0:
+		return 0, memErr("write", f.name, unix.EINVAL)
+	// checked before the end of the write is computed, which would overflow
+	case offset > math.MaxInt64-int64(len(data)):
+		return 0, memErr("write", f.name, unix.EFBIG)
+	}
+	if end := offset + int64(len(data)); end > int64(len(f.node.data)) {
+		if err := f.backend.resize(f.node, end); err != nil {
+			return 0, memErr("write", f.name, err)
+		}
+	}
+	copy(f.node.data[offset:], data)
+	f.node.mtime = time.Now()
+	f.node.ctime = f.node.mtime
+	return len(data), nil
+}
+
+func (f *memFile) Close() error {
+	f.backend.mu.Lock()
+	defer f.backend.mu.Unlock()
+	if f.closed {
+		return memErr("close", f.name, os.ErrClosed)
+	}
+	f.closed = true
+	f.node.opens--
+	f.backend.release(f.node)
+	return nil
+}
+
+func (f *memFile) Stat() (FileInfo, error) {
+	f.backend.mu.Lock()
+	defer f.backend.mu.Unlock()
+	return f.backend.stat(f.node, f.name), nil
+}
+
+func (f *memFile) ReadDir() ([]DirEntry, error) {
+	f.backend.mu.Lock()
+	defer f.backend.mu.Unlock()
+	if !f.node.mode.IsDir() {
+		return nil, memErr("readdirent", f.name, unix.ENOTDIR)
+	}
+	entries := []DirEntry{}
+	for name, child := range f.node.children {
+		entries = append(entries, &memDirEntry{f.backend, f.node, name, child.mode.Type()})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name() < entries[j].Name()
+	})
+	return entries, nil
+}
+
+func (f *memFile) Truncate(size int64) error {
+	f.backend.mu.Lock()
+	defer f.backend.mu.Unlock()
+	var err error
+	switch {
+	case f.node.mode.IsDir():
+		err = unix.EISDIR
+	case size < 0 || f.node.mode&os.ModeSymlink != 0:
+		err = unix.EINVAL
+	default:
+		err = f.backend.resize(f.node, size)
+	}
+	if err != nil {
+		return memErr("truncate", f.name, err)
+	}
+	f.node.mtime = time.Now()
+	f.node.ctime = f.node.mtime
+	return nil
+}
+
+func (f *memFile) Chmod(mode os.FileMode) error {
+	f.backend.mu.Lock()
+	defer f.backend.mu.Unlock()
+	const settable = os.ModePerm | os.ModeSetuid | os.ModeSetgid | os.ModeSticky
+	f.node.mode = f.node.mode&^settable | mode&settable
+	f.node.ctime = time.Now()
+	return nil
+}
+
+func (f *memFile) Chtimes(atime time.Time, mtime time.Time) error {
+	f.backend.mu.Lock()
+	defer f.backend.mu.Unlock()
+	if !atime.IsZero() {
+		f.node.atime = atime
+	}
+	if !mtime.IsZero() {
+		f.node.mtime = mtime
+	}
+	f.node.ctime = time.Now()
+	return nil
+}
+
+// an entry of a memory directory, looked up again when its info is asked for
+type memDirEntry struct {
+	backend *memBackend
+	dir     *memNode
+	name    string
+	typ     os.FileMode
+}
+
+func (e *memDirEntry) Name() string      { return e.name }
+func (e *memDirEntry) IsDir() bool       { return e.typ.IsDir() }
+func (e *memDirEntry) Type() os.FileMode { return e.typ }
+
+func (e *memDirEntry) Info() (FileInfo, error) {
+	e.backend.mu.Lock()
+	defer e.backend.mu.Unlock()
+	node, found := e.dir.children[e.name]
+	if !found {
+		return nil, memErr("lstat", e.name, unix.ENOENT)
+	}
+	return e.backend.stat(node, e.name), nil
+}
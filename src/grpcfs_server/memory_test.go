@@ -0,0 +1,240 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"math"
+	"os"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+func writeMemFile(t *testing.T, b *memBackend, path string, data string) {
+	t.Helper()
+	file, err := b.Open(path, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		t.Fatalf("create %v: %v", path, err)
+	}
+	defer file.Close()
+	if _, err := file.WriteAt([]byte(data), 0); err != nil {
+		t.Fatalf("write %v: %v", path, err)
+	}
+}
+
+func readMemFile(t *testing.T, b *memBackend, path string) string {
+	t.Helper()
+	file, err := b.Open(path, os.O_RDONLY, 0)
+	if err != nil {
+		t.Fatalf("open %v: %v", path, err)
+	}
+	defer file.Close()
+	dst := make([]byte, 64)
+	n, err := file.ReadAt(dst, 0)
+	if err != nil && err != io.EOF {
+		t.Fatalf("read %v: %v", path, err)
+	}
+	return string(dst[:n])
+}
+
+func TestMemLookup(t *testing.T) {
+	b := newMemBackend(1 << 20)
+	if err := b.Mkdir("a", 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Mkdir("a/b", 0755); err != nil {
+		t.Fatal(err)
+	}
+	writeMemFile(t, b, "top", "top")
+	links := map[string]string{
+		// .. stops at the root, rather than leading out of the backend
+		"a/b/up": "../../../../top",
+		// absolute targets start at the root of the backend
+		"a/abs": "/top",
+		// links to links are followed as well
+		"a/chain": "b/up",
+		"a/dir":   "/a/b",
+	}
+	for path, target := range links {
+		if err := b.Symlink(target, path); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, path := range []string{"a/b/up", "a/abs", "a/chain", "../../top", "a/dir/../../top"} {
+		if got := readMemFile(t, b, path); got != "top" {
+			t.Errorf("read %v: got %q", path, got)
+		}
+	}
+
+	info, err := b.Lstat("a/chain")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode()&os.ModeSymlink == 0 {
+		t.Errorf("lstat a/chain: got mode %v, want a symlink", info.Mode())
+	}
+	if _, err := b.Open("a/chain", os.O_RDONLY|unix.O_NOFOLLOW, 0); !errors.Is(err, unix.ELOOP) {
+		t.Errorf("open a/chain without following: got %v, want ELOOP", err)
+	}
+
+	if err := b.Symlink("loop2", "loop1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Symlink("loop1", "loop2"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.Open("loop1", os.O_RDONLY, 0); !errors.Is(err, unix.ELOOP) {
+		t.Errorf("open loop1: got %v, want ELOOP", err)
+	}
+	if _, err := b.Open("top/x", os.O_RDONLY, 0); !errors.Is(err, unix.ENOTDIR) {
+		t.Errorf("open top/x: got %v, want ENOTDIR", err)
+	}
+}
+
+func TestMemRenameIntoSubtree(t *testing.T) {
+	b := newMemBackend(1 << 20)
+	for _, path := range []string{"a", "a/b", "a/b/c"} {
+		if err := b.Mkdir(path, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, path := range []string{"a/d", "a/b/d", "a/b/c/d"} {
+		if err := b.Rename("a", path); !errors.Is(err, unix.EINVAL) {
+			t.Errorf("rename a to %v: got %v, want EINVAL", path, err)
+		}
+	}
+	if _, err := b.Lstat("a/b/c"); err != nil {
+		t.Errorf("tree changed by a failed rename: %v", err)
+	}
+
+	// moving a directory elsewhere keeps its contents, and .. follows it
+	if err := b.Rename("a/b/c", "c"); err != nil {
+		t.Fatal(err)
+	}
+	writeMemFile(t, b, "a/f", "f")
+	if err := b.Symlink("../a/f", "c/f"); err != nil {
+		t.Fatal(err)
+	}
+	if got := readMemFile(t, b, "c/f"); got != "f" {
+		t.Errorf("read c/f: got %q", got)
+	}
+}
+
+func TestMemCapacity(t *testing.T) {
+	const capacity = 64 << 10
+	b := newMemBackend(capacity)
+	file, err := b.Open("f", os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	chunk := make([]byte, 4096)
+	var written int64
+	for {
+		_, err = file.WriteAt(chunk, written)
+		if err != nil {
+			break
+		}
+		written += int64(len(chunk))
+	}
+	if !errors.Is(err, unix.ENOSPC) {
+		t.Fatalf("writing past the capacity: got %v, want ENOSPC", err)
+	}
+	if written == 0 || written > capacity {
+		t.Errorf("wrote %v bytes with a capacity of %v", written, capacity)
+	}
+	if b.used > capacity {
+		t.Errorf("used %v bytes with a capacity of %v", b.used, capacity)
+	}
+	stats, _ := b.StatFs()
+	if stats.Blocks != capacity/memBlockSize || stats.BlocksFree != 0 {
+		t.Errorf("full backend reported %v of %v blocks free", stats.BlocksFree, stats.Blocks)
+	}
+	if err := b.Mkdir("d", 0755); !errors.Is(err, unix.ENOSPC) {
+		t.Errorf("mkdir on a full backend: got %v, want ENOSPC", err)
+	}
+	if err := b.SetXattr("f", "user.k", make([]byte, 1024), 0); !errors.Is(err, unix.ENOSPC) {
+		t.Errorf("setxattr on a full backend: got %v, want ENOSPC", err)
+	}
+
+	if err := file.Truncate(0); err != nil {
+		t.Fatal(err)
+	}
+	stats, _ = b.StatFs()
+	if stats.BlocksFree != stats.Blocks-1 {
+		t.Errorf("emptied backend reported %v of %v blocks free", stats.BlocksFree, stats.Blocks)
+	}
+	if err := b.Mkdir("d", 0755); err != nil {
+		t.Errorf("mkdir once emptied: %v", err)
+	}
+
+	// writes far past the capacity fail without allocating, even where their
+	// end overflows
+	offsets := map[int64]error{
+		capacity:            unix.ENOSPC,
+		math.MaxInt64 / 2:   unix.ENOSPC,
+		math.MaxInt64 - 2:   unix.EFBIG,
+		math.MaxInt64:       unix.EFBIG,
+		math.MaxInt64 - 100: unix.ENOSPC,
+	}
+	for offset, want := range offsets {
+		if _, err := file.WriteAt(chunk[:4], offset); !errors.Is(err, want) {
+			t.Errorf("write at %v: got %v, want %v", offset, err, want)
+		}
+	}
+	if info, _ := file.Stat(); info.Size() != 0 {
+		t.Errorf("failed writes grew the file to %v bytes", info.Size())
+	}
+}
+
+func TestMemUnlinkedWhileOpen(t *testing.T) {
+	b := newMemBackend(1 << 20)
+	empty := b.used
+	writeMemFile(t, b, "f", "data")
+	file, err := b.Open("f", os.O_RDWR, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Unlink("f"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.Lstat("f"); !errors.Is(err, unix.ENOENT) {
+		t.Errorf("lstat of an unlinked file: got %v, want ENOENT", err)
+	}
+
+	// the file stays usable through its handle, and keeps its space
+	dst := make([]byte, 4)
+	if n, err := file.ReadAt(dst, 0); n != 4 || string(dst) != "data" {
+		t.Errorf("read of an unlinked file: got %q, %v", dst[:n], err)
+	}
+	if _, err := file.WriteAt([]byte("more"), 4); err != nil {
+		t.Errorf("write to an unlinked file: %v", err)
+	}
+	info, err := file.Stat()
+	if err != nil || info.Size() != 8 || info.Nlink() != 0 {
+		t.Errorf("stat of an unlinked file: got %v, %v", info, err)
+	}
+	if b.used == empty || b.nodes != 2 {
+		t.Errorf("unlinked file released while open")
+	}
+
+	if err := file.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if b.used != empty || b.nodes != 1 {
+		t.Errorf("unlinked file not released once closed: %v bytes and %v nodes in use", b.used, b.nodes)
+	}
+
+	// hard links keep a node alive once closed
+	writeMemFile(t, b, "g", "g")
+	if err := b.Link("g", "h"); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Unlink("g"); err != nil {
+		t.Fatal(err)
+	}
+	if got := readMemFile(t, b, "h"); got != "g" {
+		t.Errorf("read h: got %q", got)
+	}
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// the process at the other end of a unix socket, as told by the kernel
+type peerCredInfo struct {
+	credentials.CommonAuthInfo
+	Uid uint32
+	Gid uint32
+	Pid int32
+}
+
+func (peerCredInfo) AuthType() string {
+	return "peercred"
+}
+
+// transport credentials reading the uid and gid of the processes connecting
+// over a unix socket with SO_PEERCRED, rather than trusting what they claim
+type peerCredentials struct{}
+
+func (peerCredentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	unixConn, ok := conn.(*net.UnixConn)
+	if !ok {
+		return nil, nil, errors.New("peer credentials need a unix socket")
+	}
+	raw, err := unixConn.SyscallConn()
+	if err != nil {
+		return nil, nil, err
+	}
+	var ucred *unix.Ucred
+	var credErr error
+	err = raw.Control(func(fd uintptr) {
+		ucred, credErr = unix.GetsockoptUcred(int(fd), unix.SOL_SOCKET, unix.SO_PEERCRED)
+	})
+	if err == nil {
+		err = credErr
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	info := peerCredInfo{
+		// the connection never leaves the host
+		CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.PrivacyAndIntegrity},
+		Uid:            ucred.Uid,
+		Gid:            ucred.Gid,
+		Pid:            ucred.Pid,
+	}
+	return conn, info, nil
+}
+
+func (peerCredentials) ClientHandshake(ctx context.Context, authority string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return nil, nil, errors.New("peer credentials are server side only")
+}
+
+func (peerCredentials) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: "peercred"}
+}
+
+func (c peerCredentials) Clone() credentials.TransportCredentials {
+	return c
+}
+
+func (peerCredentials) OverrideServerName(string) error {
+	return nil
+}
+
+// the uids and gids allowed to connect over a unix socket
+type peerPolicy struct {
+	uids map[uint32]bool
+	gids map[uint32]bool
+}
+
+// builds a policy from comma separated lists of ids, allowing only the uid of
+// the server itself when both are empty
+func newPeerPolicy(uids string, gids string) (*peerPolicy, error) {
+	policy := &peerPolicy{uids: map[uint32]bool{}, gids: map[uint32]bool{}}
+	if err := parseIds(uids, policy.uids); err != nil {
+		return nil, fmt.Errorf("invalid uid: %w", err)
+	}
+	if err := parseIds(gids, policy.gids); err != nil {
+		return nil, fmt.Errorf("invalid gid: %w", err)
+	}
+	if len(policy.uids) == 0 && len(policy.gids) == 0 {
+		policy.uids[uint32(os.Getuid())] = true
+	}
+	return policy, nil
+}
+
+func parseIds(list string, ids map[uint32]bool) error {
+	for _, field := range strings.Split(list, ",") {
+		if field = strings.TrimSpace(field); field == "" {
+			continue
+		}
+		id, err := strconv.ParseUint(field, 10, 32)
+		if err != nil {
+			return err
+		}
+		ids[uint32(id)] = true
+	}
+	return nil
+}
+
+func (p *peerPolicy) authorize(ctx context.Context) error {
+	client, _ := peer.FromContext(ctx)
+	if client == nil {
+		return status.Error(codes.PermissionDenied, "unknown peer")
+	}
+	info, ok := client.AuthInfo.(peerCredInfo)
+	if !ok {
+		return status.Error(codes.PermissionDenied, "no peer credentials")
+	}
+	if !p.uids[info.Uid] && !p.gids[info.Gid] {
+		logger.Printf("rejected peer uid %v gid %v pid %v", info.Uid, info.Gid, info.Pid)
+		return status.Errorf(codes.PermissionDenied, "uid %v gid %v is not allowed", info.Uid, info.Gid)
+	}
+	return nil
+}
+
+func (p *peerPolicy) unaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := p.authorize(ctx); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (p *peerPolicy) streamInterceptor(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := p.authorize(stream.Context()); err != nil {
+		return err
+	}
+	return handler(srv, stream)
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"grpcfs/pb"
+)
+
+// builds the server side tls config. Client certificates are verified
+// against the CA bundle when one is given, and required if asked to.
+func newTLSConfig(certFile string, keyFile string, caFile string, requireClientCert bool) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if caFile == "" {
+		if requireClientCert {
+			return nil, errors.New("requiring client certificates needs a CA bundle to verify them")
+		}
+		return config, nil
+	}
+	pem, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+	config.ClientCAs = x509.NewCertPool()
+	if !config.ClientCAs.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in %v", caFile)
+	}
+	config.ClientAuth = tls.VerifyClientCertIfGiven
+	if requireClientCert {
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return config, nil
+}
+
+// the identity a client was authenticated with by its transport: the subject
+// of its verified certificate, or the uid of its process on a unix socket.
+// Empty when the transport did not authenticate it.
+func peerIdentity(ctx context.Context) string {
+	client, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	switch info := client.AuthInfo.(type) {
+	case credentials.TLSInfo:
+		if chains := info.State.VerifiedChains; len(chains) > 0 && len(chains[0]) > 0 {
+			return "x509:" + chains[0][0].Subject.String()
+		}
+	case peerCredInfo:
+		return fmt.Sprintf("uid:%v", info.Uid)
+	}
+	return ""
+}
+
+// the owner of the handles opened by a request: the agent it names, tied to
+// the identity its transport authenticated, so that handles cannot be used by
+// another client claiming the same agent
+func ownerOf(ctx context.Context, rpcCtx *pb.RPCContext) string {
+	return peerIdentity(ctx) + "/" + rpcCtx.GetAgentId()
+}